@@ -17,7 +17,9 @@ CREATE TABLE IF NOT EXISTS users (
     "followers_count" int NOT NULL,
     "followings_count" int NOT NULL,
     "created_at" timestamp(0) without time zone NOT NULL,
-    "updated_at" timestamp(0) without time zone NOT NULL
+    "updated_at" timestamp(0) without time zone NOT NULL,
+    "tweets_count" int NOT NULL DEFAULT 0,
+    "timezone" varchar NOT NULL DEFAULT 'UTC'
 );
 
 CREATE TABLE IF NOT EXISTS followers (
@@ -27,6 +29,40 @@ CREATE TABLE IF NOT EXISTS followers (
     PRIMARY KEY ("followee_id", "follower_id")
 );
 
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_idx ON users ("email");
+
+-- Prefix lookups for the mention autocomplete
+CREATE INDEX IF NOT EXISTS users_screen_name_lower_idx ON users (lower("screen_name") text_pattern_ops);
+CREATE INDEX IF NOT EXISTS users_name_lower_idx ON users (lower("name") text_pattern_ops);
+
+-- Daily signup counts for the admin stats
+CREATE INDEX IF NOT EXISTS users_created_at_idx ON users ("created_at");
+
+CREATE INDEX IF NOT EXISTS followers_follower_id_idx ON followers ("follower_id");
+
+CREATE TABLE IF NOT EXISTS invite_codes (
+    "code" varchar PRIMARY KEY,
+    "inviter_id" uuid NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
+    "max_uses" int NOT NULL DEFAULT 1,
+    "uses" int NOT NULL DEFAULT 0,
+    "expires_at" timestamp(0) without time zone NOT NULL,
+    "created_at" timestamp(0) without time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invite_codes_inviter_id_created_at_idx ON invite_codes ("inviter_id", "created_at");
+CREATE INDEX IF NOT EXISTS invite_codes_created_at_idx ON invite_codes ("created_at");
+
+-- No foreign keys on purpose, redemptions outlive deleted accounts so invite
+-- trees can still be traced when moderating
+CREATE TABLE IF NOT EXISTS invite_redemptions (
+    "invitee_id" uuid PRIMARY KEY,
+    "inviter_id" uuid NOT NULL,
+    "code" varchar NOT NULL,
+    "created_at" timestamp(0) without time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invite_redemptions_inviter_id_idx ON invite_redemptions ("inviter_id");
+
 CREATE TABLE IF NOT EXISTS tweets (
     "id" uuid DEFAULT uuid_generate_v4 () PRIMARY KEY,
     "user_id" uuid NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
@@ -37,6 +73,24 @@ CREATE TABLE IF NOT EXISTS tweets (
 );
 
 CREATE INDEX IF NOT EXISTS tweets_created_at_idx ON tweets ("created_at");
+CREATE INDEX IF NOT EXISTS tweets_user_id_created_at_idx ON tweets ("user_id", "created_at");
+
+-- Keeps users.tweets_count in sync so profiles don't need a COUNT(*) on tweets
+CREATE OR REPLACE FUNCTION update_user_tweets_count() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE users SET "tweets_count" = "tweets_count" + 1 WHERE "id" = NEW."user_id";
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE users SET "tweets_count" = "tweets_count" - 1 WHERE "id" = OLD."user_id";
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER tweets_update_user_tweets_count
+    AFTER INSERT OR DELETE ON tweets
+    FOR EACH ROW EXECUTE FUNCTION update_user_tweets_count();
 
 CREATE TABLE IF NOT EXISTS tweet_entities (
     "tweet_id" uuid REFERENCES tweets ON DELETE CASCADE,
@@ -59,6 +113,8 @@ CREATE TABLE IF NOT EXISTS retweets (
 CREATE TABLE IF NOT EXISTS favorites (
     "user_id" uuid NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
     "tweet_id" uuid NOT NULL REFERENCES tweets ("id") ON DELETE CASCADE,
+    -- NULL for favorites made before the time was recorded
+    "created_at" timestamp(0) without time zone DEFAULT (now() AT TIME ZONE 'UTC'),
     PRIMARY KEY ("tweet_id", "user_id")
 );
 
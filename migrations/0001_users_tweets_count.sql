@@ -0,0 +1,33 @@
+-- Adds the users.tweets_count counter to databases created before it was part
+-- of init.sql, and backfills it from the existing tweets.
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "tweets_count" int NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION update_user_tweets_count() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE users SET "tweets_count" = "tweets_count" + 1 WHERE "id" = NEW."user_id";
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE users SET "tweets_count" = "tweets_count" - 1 WHERE "id" = OLD."user_id";
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Lock tweets so no insert or delete slips in between the backfill and the
+-- trigger creation
+LOCK TABLE tweets IN SHARE ROW EXCLUSIVE MODE;
+
+CREATE OR REPLACE TRIGGER tweets_update_user_tweets_count
+    AFTER INSERT OR DELETE ON tweets
+    FOR EACH ROW EXECUTE FUNCTION update_user_tweets_count();
+
+UPDATE users SET "tweets_count" = counts."count"
+FROM (
+    SELECT "user_id", COUNT(*) AS "count" FROM tweets GROUP BY "user_id"
+) AS counts
+WHERE users."id" = counts."user_id";
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- Adds single-use invite codes for registration
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS invite_codes (
+    "code" varchar PRIMARY KEY,
+    "inviter_id" uuid NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
+    "invitee_id" uuid REFERENCES users ("id") ON DELETE SET NULL,
+    "expires_at" timestamp(0) without time zone NOT NULL,
+    "used_at" timestamp(0) without time zone,
+    "created_at" timestamp(0) without time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invite_codes_inviter_id_created_at_idx ON invite_codes ("inviter_id", "created_at");
+
+COMMIT;
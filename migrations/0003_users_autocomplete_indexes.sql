@@ -0,0 +1,10 @@
+-- Adds the indexes backing the mention autocomplete to databases created
+-- before they were part of init.sql.
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS users_screen_name_lower_idx ON users (lower("screen_name") text_pattern_ops);
+CREATE INDEX IF NOT EXISTS users_name_lower_idx ON users (lower("name") text_pattern_ops);
+
+CREATE INDEX IF NOT EXISTS followers_follower_id_idx ON followers ("follower_id");
+
+COMMIT;
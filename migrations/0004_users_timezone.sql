@@ -0,0 +1,7 @@
+-- Adds the users.timezone column to databases created before it was part of
+-- init.sql, existing users keep UTC.
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "timezone" varchar NOT NULL DEFAULT 'UTC';
+
+COMMIT;
@@ -0,0 +1,11 @@
+-- Adds favorites.created_at and the tweets index used by the activity heatmap
+-- to databases created before they were part of init.sql. Existing favorites
+-- have no known time so they are left NULL and don't show up in the heatmap.
+BEGIN;
+
+ALTER TABLE favorites ADD COLUMN IF NOT EXISTS "created_at" timestamp(0) without time zone;
+ALTER TABLE favorites ALTER COLUMN "created_at" SET DEFAULT (now() AT TIME ZONE 'UTC');
+
+CREATE INDEX IF NOT EXISTS tweets_user_id_created_at_idx ON tweets ("user_id", "created_at");
+
+COMMIT;
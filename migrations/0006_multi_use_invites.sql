@@ -0,0 +1,40 @@
+-- Turns single-use invite codes into codes with a usage limit and copies the
+-- invitee of every used code to invite_redemptions. The old columns are
+-- dropped by 0007_drop_single_use_invite_columns.sql.
+BEGIN;
+
+ALTER TABLE invite_codes ADD COLUMN IF NOT EXISTS "max_uses" int NOT NULL DEFAULT 1;
+ALTER TABLE invite_codes ADD COLUMN IF NOT EXISTS "uses" int NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS invite_codes_created_at_idx ON invite_codes ("created_at");
+
+-- No foreign keys on purpose, redemptions outlive deleted accounts so invite
+-- trees can still be traced when moderating
+CREATE TABLE IF NOT EXISTS invite_redemptions (
+    "invitee_id" uuid PRIMARY KEY,
+    "inviter_id" uuid NOT NULL,
+    "code" varchar NOT NULL,
+    "created_at" timestamp(0) without time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invite_redemptions_inviter_id_idx ON invite_redemptions ("inviter_id");
+
+-- Only backfill while the old columns are still there
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE "table_name" = 'invite_codes' AND "column_name" = 'used_at'
+    ) THEN
+        UPDATE invite_codes SET "uses" = 1 WHERE "used_at" IS NOT NULL;
+
+        INSERT INTO invite_redemptions ("invitee_id", "inviter_id", "code", "created_at")
+        SELECT "invitee_id", "inviter_id", "code", "used_at"
+        FROM invite_codes
+        WHERE "invitee_id" IS NOT NULL
+        ON CONFLICT DO NOTHING;
+    END IF;
+END;
+$$;
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- Drops the single-use invite columns once 0006_multi_use_invites.sql has
+-- copied them to invite_redemptions.
+--
+-- Drops columns: apply to the writer first, then the replica.
+BEGIN;
+
+ALTER TABLE invite_codes DROP COLUMN IF EXISTS "invitee_id";
+ALTER TABLE invite_codes DROP COLUMN IF EXISTS "used_at";
+
+COMMIT;
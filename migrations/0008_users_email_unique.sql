@@ -0,0 +1,8 @@
+-- Adds the unique index on users.email to databases created before it was
+-- part of init.sql. Fails if two users already share an email, merge or
+-- rename those accounts first.
+BEGIN;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_idx ON users ("email");
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Adds the index the daily signup counts of ListDailyStats range over to
+-- databases created before it was part of init.sql.
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS users_created_at_idx ON users ("created_at");
+
+COMMIT;
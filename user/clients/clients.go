@@ -21,7 +21,7 @@ func NewClients(ctx context.Context, cfg *config.Config) (Clients, error) {
 
 	group.Go(func() error {
 		var err error
-		c.WriterDB, err = postgres.NewPostgreSQLClient(ctx, cfg.Clients.WriterDbURL)
+		c.WriterDB, err = postgres.NewPostgreSQLClient(ctx, cfg.Clients.WriterDbURL, cfg.Clients.DbStatementTimeout)
 		if err != nil {
 			return err
 		}
@@ -31,7 +31,7 @@ func NewClients(ctx context.Context, cfg *config.Config) (Clients, error) {
 
 	group.Go(func() error {
 		var err error
-		c.ReaderDB, err = postgres.NewPostgreSQLClient(ctx, cfg.Clients.ReaderDbURL)
+		c.ReaderDB, err = postgres.NewPostgreSQLClient(ctx, cfg.Clients.ReaderDbURL, cfg.Clients.DbStatementTimeout)
 		if err != nil {
 			return err
 		}
@@ -2,16 +2,25 @@ package postgres
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
-func NewPostgreSQLClient(ctx context.Context, connString string) (*pgxpool.Pool, error) {
+// NewPostgreSQLClient creates a new connection pool. A non-zero statementTimeout
+// is set as the statement_timeout of every connection so runaway queries get
+// aborted by the server instead of holding on to a connection.
+func NewPostgreSQLClient(ctx context.Context, connString string, statementTimeout time.Duration) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(connString)
 	if err != nil {
 		return nil, err
 	}
 
+	if statementTimeout > 0 {
+		config.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(statementTimeout.Milliseconds(), 10)
+	}
+
 	connection, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		return nil, err
@@ -5,9 +5,13 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	// Embeds the tz database so timezone validation doesn't depend on the
+	// host's zoneinfo
+	_ "time/tzdata"
 
 	"github.com/HotPotatoC/twitter-clone/user/clients"
 	"github.com/HotPotatoC/twitter-clone/user/config"
+	"github.com/HotPotatoC/twitter-clone/user/internal/namecheck"
 	"github.com/HotPotatoC/twitter-clone/user/internal/server"
 	"github.com/HotPotatoC/twitter-clone/user/internal/service"
 	"github.com/HotPotatoC/twitter-clone/user/logger"
@@ -16,7 +20,15 @@ import (
 )
 
 func main() {
-	logger.Init(true)
+	cfg := config.New()
+
+	logger.Init(!cfg.IsProduction())
+
+	if err := cfg.Validate(); err != nil {
+		logger.M.Fatal(err.Error())
+	}
+
+	logger.Startup.Infof("loaded config: %+v", cfg.Redacted())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -30,15 +42,33 @@ func main() {
 		cancel()
 	}()
 
-	cfg := config.New()
-
 	clients, err := clients.NewClients(ctx, cfg)
 	if err != nil {
 		logger.M.Fatal(err.Error())
 	}
 
-	service := service.NewService(clients)
-	server := server.New(cfg, service)
+	names, err := namecheck.New(cfg.Names.ReservedHandlesPath, cfg.Names.ProfanityListPath)
+	if err != nil {
+		logger.M.Fatal(err.Error())
+	}
+
+	// Reload the name lists on SIGHUP so they can be edited without a restart
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGHUP)
+
+		for range c {
+			if err := names.Reload(); err != nil {
+				logger.M.Errorf("failed to reload name lists: %v", err)
+				continue
+			}
+
+			logger.M.Info("reloaded name lists")
+		}
+	}()
+
+	service := service.NewService(cfg, clients)
+	server := server.New(cfg, service, names)
 
 	group, groupCtx := errgroup.WithContext(ctx)
 
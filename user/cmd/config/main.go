@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/HotPotatoC/twitter-clone/user/config"
+	_ "github.com/joho/godotenv/autoload"
+)
+
+const usage = `usage: config <command>
+
+commands:
+  validate  validates the config loaded from the environment
+  print     prints the config with secrets redacted`
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg := config.New()
+
+	switch os.Args[1] {
+	case "validate":
+		if err := cfg.Validate(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fmt.Println("config is valid")
+	case "print":
+		fmt.Printf("%+v\n", cfg.Redacted())
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
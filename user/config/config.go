@@ -1,32 +1,270 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	EnvDevelopment = "development"
+	EnvProduction  = "production"
+)
 
 type ClientsConfig struct {
-	WriterDbURL string
-	ReaderDbURL string
+	WriterDbURL        string
+	ReaderDbURL        string
+	DbStatementTimeout time.Duration
 }
 
 type AppConfig struct {
+	Env     string
 	Address string
+	// RequestTimeout is the budget of the twirp RPCs, 0 disables it
+	RequestTimeout time.Duration
+	// AvatarRequestTimeout is the budget of the default avatar route, which
+	// renders images on a cache miss, 0 disables it
+	AvatarRequestTimeout time.Duration
+	CursorSecret         string
+	// GatewayToken is shared with the gateway, requests carrying it are
+	// trusted to name the signed-in user. Empty disables authenticated users
+	GatewayToken string
+	// PublicURL is the base URL clients reach the service's HTTP routes on,
+	// defaults to localhost on PORT outside of production
+	PublicURL string
+}
+
+type CacheConfig struct {
+	NotFoundTTL time.Duration
+	// UserTTL is how long users resolved in batches, e.g. for tweet authors,
+	// are reused
+	UserTTL time.Duration
+	// AutocompleteTTL is how long the social graph suggestions of a user
+	// are reused by the mention autocomplete
+	AutocompleteTTL time.Duration
+	// ActivityTTL is how long the activity heatmap of a user is reused
+	ActivityTTL time.Duration
+	// StatsTTL is how long the daily stats shown to admins are reused
+	StatsTTL time.Duration
+}
+
+type RegistrationConfig struct {
+	Open bool
+	// InviteTokens allow signing up while registration is closed
+	InviteTokens []string
+	// InvitesPerDay is how many invite codes a user can generate a day
+	InvitesPerDay int
+	// InviteTTL is the default and, for non-admins, the longest lifetime of
+	// an invite code
+	InviteTTL time.Duration
+	// MaxInviteUses is how many signups a code generated by a non-admin can
+	// be redeemed for at most
+	MaxInviteUses int
+	// AdminIDs are the users allowed to generate invites without limits and
+	// to list every invite, only when authenticated through the gateway
+	AdminIDs []string
+	// AnyUserCanInvite allows users other than admins to generate invites
+	AnyUserCanInvite bool
+}
+
+type NamesConfig struct {
+	ReservedHandlesPath string
+	ProfanityListPath   string
 }
 
 type Config struct {
-	App     AppConfig
-	Clients ClientsConfig
+	App          AppConfig
+	Clients      ClientsConfig
+	Cache        CacheConfig
+	Names        NamesConfig
+	Registration RegistrationConfig
+
+	// lookupErrors are the env values that couldn't be parsed, reported by
+	// Validate
+	lookupErrors []string
 }
 
 func New() *Config {
 	c := new(Config)
 
+	port := lookup(c, "PORT", 7000)
+
 	c.App = AppConfig{
-		Address: fmt.Sprintf(":%d", LookupEnv("PORT", 7000)),
+		Env:                  lookup(c, "APP_ENV", EnvDevelopment),
+		Address:              fmt.Sprintf(":%d", port),
+		RequestTimeout:       lookup(c, "REQUEST_TIMEOUT", 5*time.Second),
+		AvatarRequestTimeout: lookup(c, "AVATAR_REQUEST_TIMEOUT", 10*time.Second),
+		CursorSecret:         lookup(c, "CURSOR_SECRET", ""),
+		GatewayToken:         lookup(c, "GATEWAY_TOKEN", ""),
+		PublicURL:            lookup(c, "PUBLIC_URL", ""),
+	}
+
+	if c.App.PublicURL == "" && !c.IsProduction() {
+		c.App.PublicURL = fmt.Sprintf("http://localhost:%d", port)
 	}
 
 	c.Clients = ClientsConfig{
-		WriterDbURL: LookupEnv("DB_WRITER_URL", ""),
-		ReaderDbURL: LookupEnv("DB_READER_URL", ""),
+		WriterDbURL:        lookup(c, "DB_WRITER_URL", ""),
+		ReaderDbURL:        lookup(c, "DB_READER_URL", ""),
+		DbStatementTimeout: lookup(c, "DB_STATEMENT_TIMEOUT", 5*time.Second),
+	}
+
+	c.Cache = CacheConfig{
+		NotFoundTTL:     lookup(c, "CACHE_NOT_FOUND_TTL", 10*time.Second),
+		UserTTL:         lookup(c, "CACHE_USER_TTL", 30*time.Second),
+		AutocompleteTTL: lookup(c, "CACHE_AUTOCOMPLETE_TTL", 3*time.Minute),
+		ActivityTTL:     lookup(c, "CACHE_ACTIVITY_TTL", time.Hour),
+		StatsTTL:        lookup(c, "CACHE_STATS_TTL", 10*time.Minute),
+	}
+
+	c.Names = NamesConfig{
+		ReservedHandlesPath: lookup(c, "RESERVED_HANDLES_PATH", ""),
+		ProfanityListPath:   lookup(c, "PROFANITY_LIST_PATH", ""),
+	}
+
+	c.Registration = RegistrationConfig{
+		Open:             lookup(c, "REGISTRATION_OPEN", true),
+		InviteTokens:     lookup(c, "REGISTRATION_INVITE_TOKENS", []string{}),
+		InvitesPerDay:    lookup(c, "REGISTRATION_INVITES_PER_DAY", 5),
+		InviteTTL:        lookup(c, "REGISTRATION_INVITE_TTL", 7*24*time.Hour),
+		MaxInviteUses:    lookup(c, "REGISTRATION_MAX_INVITE_USES", 1),
+		AdminIDs:         lookup(c, "REGISTRATION_ADMIN_IDS", []string{}),
+		AnyUserCanInvite: lookup(c, "REGISTRATION_ANY_USER_CAN_INVITE", true),
 	}
 
 	return c
 }
+
+// lookup looks up an env value with LookupEnv, recording parse errors on c so
+// a typo fails Validate instead of silently using a fallback
+func lookup[T EnvTypes](c *Config, name string, defaultValue T) T {
+	value, err := LookupEnv(name, defaultValue)
+	if err != nil {
+		c.lookupErrors = append(c.lookupErrors, err.Error())
+	}
+
+	return value
+}
+
+// IsProduction reports whether the app runs in the production environment
+func (c *Config) IsProduction() bool {
+	return c.App.Env == EnvProduction
+}
+
+// Validate checks the config for missing or invalid values, it should be
+// called once at startup so a misconfigured deployment fails early
+func (c *Config) Validate() error {
+	problems := append([]string{}, c.lookupErrors...)
+
+	if c.App.Env != EnvDevelopment && c.App.Env != EnvProduction {
+		problems = append(problems, fmt.Sprintf("APP_ENV must be %q or %q", EnvDevelopment, EnvProduction))
+	}
+
+	if c.App.RequestTimeout < 0 {
+		problems = append(problems, "REQUEST_TIMEOUT must not be negative")
+	}
+
+	if c.App.AvatarRequestTimeout < 0 {
+		problems = append(problems, "AVATAR_REQUEST_TIMEOUT must not be negative")
+	}
+
+	if c.IsProduction() && c.App.CursorSecret == "" {
+		problems = append(problems, "CURSOR_SECRET is required in production")
+	}
+
+	// Only left empty in production
+	if c.App.PublicURL == "" {
+		problems = append(problems, "PUBLIC_URL is required in production")
+	} else if !isAbsoluteURL(c.App.PublicURL) {
+		problems = append(problems, fmt.Sprintf("PUBLIC_URL must be an absolute URL, got %q", c.App.PublicURL))
+	}
+
+	if c.Clients.WriterDbURL == "" {
+		problems = append(problems, "DB_WRITER_URL is required")
+	}
+
+	if c.Clients.ReaderDbURL == "" {
+		problems = append(problems, "DB_READER_URL is required")
+	}
+
+	if c.Clients.DbStatementTimeout < 0 {
+		problems = append(problems, "DB_STATEMENT_TIMEOUT must not be negative")
+	}
+
+	if c.Cache.NotFoundTTL < 0 {
+		problems = append(problems, "CACHE_NOT_FOUND_TTL must not be negative")
+	}
+
+	if c.Cache.UserTTL < 0 {
+		problems = append(problems, "CACHE_USER_TTL must not be negative")
+	}
+
+	if c.Cache.AutocompleteTTL < 0 {
+		problems = append(problems, "CACHE_AUTOCOMPLETE_TTL must not be negative")
+	}
+
+	if c.Cache.ActivityTTL < 0 {
+		problems = append(problems, "CACHE_ACTIVITY_TTL must not be negative")
+	}
+
+	if c.Cache.StatsTTL < 0 {
+		problems = append(problems, "CACHE_STATS_TTL must not be negative")
+	}
+
+	if c.Registration.InvitesPerDay < 0 {
+		problems = append(problems, "REGISTRATION_INVITES_PER_DAY must not be negative")
+	}
+
+	if c.Registration.InviteTTL <= 0 {
+		problems = append(problems, "REGISTRATION_INVITE_TTL must be positive")
+	}
+
+	if c.Registration.MaxInviteUses <= 0 {
+		problems = append(problems, "REGISTRATION_MAX_INVITE_USES must be positive")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid config: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// Redacted returns a copy of the config with secrets masked, safe for logging
+func (c *Config) Redacted() Config {
+	redacted := *c
+
+	if c.App.CursorSecret != "" {
+		redacted.App.CursorSecret = "<redacted>"
+	}
+
+	if c.App.GatewayToken != "" {
+		redacted.App.GatewayToken = "<redacted>"
+	}
+
+	if len(c.Registration.InviteTokens) > 0 {
+		redacted.Registration.InviteTokens = []string{"<redacted>"}
+	}
+
+	redacted.Clients.WriterDbURL = redactURL(c.Clients.WriterDbURL)
+	redacted.Clients.ReaderDbURL = redactURL(c.Clients.ReaderDbURL)
+
+	return redacted
+}
+
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "<redacted>"
+	}
+
+	return u.Redacted()
+}
+
+// isAbsoluteURL reports whether rawURL has both a scheme and a host
+func isAbsoluteURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+
+	return err == nil && u.IsAbs() && u.Host != ""
+}
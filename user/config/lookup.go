@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -8,14 +9,16 @@ import (
 )
 
 type EnvTypes interface {
-	string | []string | int | time.Duration
+	string | []string | int | bool | time.Duration
 }
 
-// LookupEnv is a generic type implementation to search env keys
-func LookupEnv[T EnvTypes](name string, defaultValue T) T {
+// LookupEnv is a generic type implementation to search env keys. The default
+// value is returned when the key is not set, an error when its value can't be
+// parsed as T
+func LookupEnv[T EnvTypes](name string, defaultValue T) (T, error) {
 	value, ok := os.LookupEnv(name)
 	if !ok {
-		return defaultValue
+		return defaultValue, nil
 	}
 
 	var result any
@@ -27,14 +30,24 @@ func LookupEnv[T EnvTypes](name string, defaultValue T) T {
 		strs := strings.Split(value, ",")
 		result = strs
 	case int:
-		i, _ := strconv.ParseInt(value, 10, 64)
-		result = int(i)
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return defaultValue, fmt.Errorf("%s must be an integer, got %q", name, value)
+		}
+		result = i
+	case bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return defaultValue, fmt.Errorf("%s must be true or false, got %q", name, value)
+		}
+		result = b
 	case time.Duration:
-		var err error
-		if result, err = time.ParseDuration(value); err != nil {
-			return defaultValue
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return defaultValue, fmt.Errorf("%s must be a duration such as 5s or 1m, got %q", name, value)
 		}
+		result = d
 	}
 
-	return result.(T)
+	return result.(T), nil
 }
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLookupEnvUnset(t *testing.T) {
+	got, err := LookupEnv("CONFIG_TEST_UNSET", 42)
+	if err != nil || got != 42 {
+		t.Errorf("LookupEnv = %d, %v, want the default", got, err)
+	}
+}
+
+func TestLookupEnvParses(t *testing.T) {
+	t.Setenv("CONFIG_TEST_STRING", "value")
+	t.Setenv("CONFIG_TEST_LIST", "a,b")
+	t.Setenv("CONFIG_TEST_INT", "8080")
+	t.Setenv("CONFIG_TEST_DURATION", "1m30s")
+	t.Setenv("CONFIG_TEST_BOOL", "false")
+
+	if got, err := LookupEnv("CONFIG_TEST_STRING", ""); err != nil || got != "value" {
+		t.Errorf("string = %q, %v", got, err)
+	}
+
+	if got, err := LookupEnv("CONFIG_TEST_LIST", []string{}); err != nil || !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("list = %v, %v", got, err)
+	}
+
+	if got, err := LookupEnv("CONFIG_TEST_INT", 0); err != nil || got != 8080 {
+		t.Errorf("int = %d, %v", got, err)
+	}
+
+	if got, err := LookupEnv("CONFIG_TEST_DURATION", time.Second); err != nil || got != 90*time.Second {
+		t.Errorf("duration = %s, %v", got, err)
+	}
+
+	if got, err := LookupEnv("CONFIG_TEST_BOOL", true); err != nil || got {
+		t.Errorf("bool = %t, %v", got, err)
+	}
+}
+
+func TestLookupEnvInvalid(t *testing.T) {
+	t.Setenv("CONFIG_TEST_INT", "five")
+	t.Setenv("CONFIG_TEST_DURATION", "5")
+
+	if _, err := LookupEnv("CONFIG_TEST_INT", 0); err == nil {
+		t.Error("an invalid integer was accepted")
+	}
+
+	if _, err := LookupEnv("CONFIG_TEST_DURATION", time.Second); err == nil {
+		t.Error("a duration without a unit was accepted")
+	}
+
+	// "no" must not fall back to the default and leave registration open
+	for _, value := range []string{"no", "off", "closed"} {
+		t.Setenv("CONFIG_TEST_BOOL", value)
+
+		if _, err := LookupEnv("CONFIG_TEST_BOOL", true); err == nil {
+			t.Errorf("%q was accepted as a bool", value)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package identicon
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"sync"
+)
+
+const (
+	// gridSize is the number of cells on each side of the pattern
+	gridSize = 5
+	// cellSize is the width and height of a cell in pixels
+	cellSize = 40
+	// Size is the width and height of the generated images in pixels, the
+	// pattern is surrounded by half a cell of margin
+	Size = (gridSize + 1) * cellSize
+
+	// maxCachedImages bounds the memory used by the cache
+	maxCachedImages = 5000
+)
+
+var background = color.RGBA{R: 240, G: 240, B: 240, A: 255}
+
+// Generate generates a deterministic PNG identicon for the given key, the
+// same key always produces the same image
+func Generate(key string) ([]byte, error) {
+	hash := sha256.Sum256([]byte(key))
+
+	fill := color.RGBA{R: hash[0], G: hash[1], B: hash[2], A: 255}
+
+	img := image.NewRGBA(image.Rect(0, 0, Size, Size))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: background}, image.Point{}, draw.Src)
+
+	// Only the left half (plus the middle column) comes from the hash, the
+	// right half mirrors it
+	half := (gridSize + 1) / 2
+	for row := 0; row < gridSize; row++ {
+		for col := 0; col < half; col++ {
+			if hash[3+row*half+col]%2 == 0 {
+				continue
+			}
+
+			fillCell(img, row, col, fill)
+			fillCell(img, row, gridSize-1-col, fill)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func fillCell(img *image.RGBA, row, col int, c color.Color) {
+	margin := cellSize / 2
+	x := margin + col*cellSize
+	y := margin + row*cellSize
+
+	draw.Draw(img, image.Rect(x, y, x+cellSize, y+cellSize), &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+// Cache keeps generated identicons in memory
+type Cache struct {
+	mu     sync.Mutex
+	images map[string][]byte
+}
+
+func NewCache() *Cache {
+	return &Cache{images: make(map[string][]byte)}
+}
+
+// Get returns the identicon for key, generating it on a cache miss
+func (c *Cache) Get(key string) ([]byte, error) {
+	c.mu.Lock()
+	img, ok := c.images[key]
+	c.mu.Unlock()
+
+	if ok {
+		return img, nil
+	}
+
+	img, err := Generate(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.images) >= maxCachedImages {
+		c.images = make(map[string][]byte)
+	}
+
+	c.images[key] = img
+
+	return img, nil
+}
@@ -0,0 +1,147 @@
+package identicon
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden images in testdata")
+
+// The golden images pin the output of Generate, changing it changes the
+// avatar of every user without a profile image. Run the tests with -update
+// to rewrite them after an intentional change
+func TestGenerateGolden(t *testing.T) {
+	keys := map[string]string{
+		"user":  "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01",
+		"other": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		"empty": "",
+	}
+
+	for name, key := range keys {
+		t.Run(name, func(t *testing.T) {
+			got, err := Generate(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", name+".png")
+
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Compare pixels rather than bytes, the encoder may change
+			// between Go versions
+			if !samePixels(t, got, want) {
+				t.Errorf("Generate(%q) doesn't match %s", key, golden)
+			}
+		})
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first, err := Generate("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := Generate("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(first, second) {
+		t.Error("the same key generated different images")
+	}
+
+	other, err := Generate("other key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if samePixels(t, first, other) {
+		t.Error("different keys generated the same image")
+	}
+}
+
+func TestGenerateSize(t *testing.T) {
+	data, err := Generate("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bounds := img.Bounds(); bounds.Dx() != Size || bounds.Dy() != Size {
+		t.Errorf("size = %dx%d, want %dx%d", bounds.Dx(), bounds.Dy(), Size, Size)
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache()
+
+	first, err := c.Get("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := c.Get("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if &first[0] != &second[0] {
+		t.Error("the second Get generated the image again")
+	}
+}
+
+func samePixels(t *testing.T, a, b []byte) bool {
+	t.Helper()
+
+	imgA, err := png.Decode(bytes.NewReader(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imgB, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imgA.Bounds() != imgB.Bounds() {
+		return false
+	}
+
+	bounds := imgA.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if !sameColor(imgA, imgB, x, y) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func sameColor(a, b image.Image, x, y int) bool {
+	r1, g1, b1, a1 := a.At(x, y).RGBA()
+	r2, g2, b2, a2 := b.At(x, y).RGBA()
+
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	userpb "github.com/HotPotatoC/twitter-clone/user/rpc/user"
+)
+
+// ActivityDay holds what happened around a user on a single day in their
+// time zone
+type ActivityDay struct {
+	Date            time.Time `json:"date"`
+	Tweets          int       `json:"tweets"`
+	LikesReceived   int       `json:"likes_received"`
+	RepliesReceived int       `json:"replies_received"`
+	NewFollowers    int       `json:"new_followers"`
+}
+
+func (d ActivityDay) PB() *userpb.ActivityDay {
+	return &userpb.ActivityDay{
+		Date:            d.Date.Format("2006-01-02"),
+		Tweets:          int32(d.Tweets),
+		LikesReceived:   int32(d.LikesReceived),
+		RepliesReceived: int32(d.RepliesReceived),
+		NewFollowers:    int32(d.NewFollowers),
+	}
+}
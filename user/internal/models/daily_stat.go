@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	userpb "github.com/HotPotatoC/twitter-clone/user/rpc/user"
+)
+
+// DailyStat holds how many users signed up and how many tweets were posted
+// on a single day
+type DailyStat struct {
+	Date    time.Time `json:"date"`
+	Signups int       `json:"signups"`
+	Tweets  int       `json:"tweets"`
+}
+
+func (d DailyStat) PB() *userpb.DailyStat {
+	return &userpb.DailyStat{
+		Date:    d.Date.Format("2006-01-02"),
+		Signups: int32(d.Signups),
+		Tweets:  int32(d.Tweets),
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	userpb "github.com/HotPotatoC/twitter-clone/user/rpc/user"
+)
+
+// FollowerGrowthBucket holds the followers a user gained over a day, week or
+// month starting at Start in their time zone. Followers counts the current
+// followers who had followed by the end of the bucket
+type FollowerGrowthBucket struct {
+	Start        time.Time `json:"start"`
+	NewFollowers int       `json:"new_followers"`
+	Followers    int       `json:"followers"`
+}
+
+func (b FollowerGrowthBucket) PB() *userpb.FollowerGrowthBucket {
+	return &userpb.FollowerGrowthBucket{
+		Start:        b.Start.Format("2006-01-02"),
+		NewFollowers: int32(b.NewFollowers),
+		Followers:    int32(b.Followers),
+	}
+}
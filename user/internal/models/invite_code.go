@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	userpb "github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InviteCode represents a code allowing up to MaxUses people to sign up
+type InviteCode struct {
+	Code      string    `json:"code"`
+	InviterID string    `json:"inviter_id"`
+	MaxUses   int       `json:"max_uses"`
+	Uses      int       `json:"uses"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (i InviteCode) PB() *userpb.InviteCode {
+	return &userpb.InviteCode{
+		Code:      i.Code,
+		InviterId: i.InviterID,
+		ExpiresAt: timestamppb.New(i.ExpiresAt),
+		CreatedAt: timestamppb.New(i.CreatedAt),
+		MaxUses:   int32(i.MaxUses),
+		Uses:      int32(i.Uses),
+	}
+}
+
+// Usable reports whether the code can still be redeemed at the given time
+func (i InviteCode) Usable(at time.Time) bool {
+	return i.Uses < i.MaxUses && at.Before(i.ExpiresAt)
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInviteCodeUsable(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		invite InviteCode
+		want   bool
+	}{
+		"unused":      {invite: InviteCode{MaxUses: 1, ExpiresAt: now.Add(time.Hour)}, want: true},
+		"uses left":   {invite: InviteCode{MaxUses: 3, Uses: 2, ExpiresAt: now.Add(time.Hour)}, want: true},
+		"used up":     {invite: InviteCode{MaxUses: 3, Uses: 3, ExpiresAt: now.Add(time.Hour)}},
+		"over limit":  {invite: InviteCode{MaxUses: 1, Uses: 2, ExpiresAt: now.Add(time.Hour)}},
+		"expired":     {invite: InviteCode{MaxUses: 1, ExpiresAt: now.Add(-time.Hour)}},
+		"expires now": {invite: InviteCode{MaxUses: 1, ExpiresAt: now}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.invite.Usable(now); got != tt.want {
+				t.Errorf("Usable() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	userpb "github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TopTweet is a tweet along with the likes and replies it received from
+// other users
+type TopTweet struct {
+	ID        string    `json:"tweet_id"`
+	Content   string    `json:"content"`
+	Likes     int       `json:"likes"`
+	Replies   int       `json:"replies"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Engagement is what top tweets are ranked by
+func (t TopTweet) Engagement() int {
+	return t.Likes + t.Replies
+}
+
+func (t TopTweet) PB() *userpb.TopTweet {
+	return &userpb.TopTweet{
+		TweetId:   t.ID,
+		Content:   t.Content,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		Likes:     int32(t.Likes),
+		Replies:   int32(t.Replies),
+	}
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	userpb "github.com/HotPotatoC/twitter-clone/user/rpc/user"
+)
+
+// TweetEngagementDay holds how many likes, replies and retweets a tweet got
+// from other users on a single day in its author's time zone
+type TweetEngagementDay struct {
+	Date     time.Time `json:"date"`
+	Likes    int       `json:"likes"`
+	Replies  int       `json:"replies"`
+	Retweets int       `json:"retweets"`
+}
+
+func (d TweetEngagementDay) PB() *userpb.TweetEngagementDay {
+	return &userpb.TweetEngagementDay{
+		Date:     d.Date.Format("2006-01-02"),
+		Likes:    int32(d.Likes),
+		Replies:  int32(d.Replies),
+		Retweets: int32(d.Retweets),
+	}
+}
@@ -28,6 +28,10 @@ type User struct {
 	FollowingsCount  int       `json:"followings_count"`
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
+	TweetsCount      int       `json:"tweets_count"`
+	// Timezone is the IANA name of the zone wall-clock times of the user are
+	// interpreted in, e.g. Asia/Jakarta
+	Timezone string `json:"timezone"`
 }
 
 func (u User) PB() *userpb.User {
@@ -46,9 +50,41 @@ func (u User) PB() *userpb.User {
 		FollowingsCount:  int32(u.FollowingsCount),
 		CreatedAt:        timestamppb.New(u.CreatedAt),
 		UpdatedAt:        timestamppb.New(u.UpdatedAt),
+		TweetsCount:      int32(u.TweetsCount),
+		Timezone:         u.Timezone,
 	}
 }
 
+// SummaryPB returns the minimal protobuf representation of the user
+func (u User) SummaryPB() *userpb.UserSummary {
+	return &userpb.UserSummary{
+		UserId:          u.ID,
+		Name:            u.Name,
+		ScreenName:      u.ScreenName,
+		ProfileImageUrl: u.ProfileImageURL,
+	}
+}
+
+// DefaultProfileImagePath is the path of the generated avatar served for
+// users without a profile image
+func DefaultProfileImagePath(userID string) string {
+	return "/avatars/default/" + userID + ".png"
+}
+
+// DefaultTimezone is the zone of users who didn't pick one
+const DefaultTimezone = "UTC"
+
+// ValidTimezone reports whether name is an IANA time zone name. Empty and
+// "Local" are rejected since they resolve to the server's zone
+func ValidTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
 // Validate validates the user fields
 func (u User) Validate() error {
 	if u.ScreenName == "" {
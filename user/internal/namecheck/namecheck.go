@@ -0,0 +1,181 @@
+package namecheck
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed reserved_handles.txt
+var defaultReservedHandles []byte
+
+// Checker validates handles and display names against the reserved handles
+// and profanity lists. The lists are loaded from files and can be reloaded
+// at runtime
+type Checker struct {
+	reservedHandlesPath string
+	profanityListPath   string
+
+	mu              sync.RWMutex
+	reservedHandles map[string]struct{}
+	profanity       profanityList
+}
+
+// profanityList holds the words matched against whole name tokens and the
+// ones marked with a leading * in the list file, which match anywhere in a
+// name
+type profanityList struct {
+	words      map[string]struct{}
+	substrings []string
+}
+
+// New creates a checker. The built-in reserved handles are always included,
+// reservedHandlesPath adds more of them and profanityListPath enables the
+// profanity check, both paths are optional
+func New(reservedHandlesPath, profanityListPath string) (*Checker, error) {
+	c := &Checker{
+		reservedHandlesPath: reservedHandlesPath,
+		profanityListPath:   profanityListPath,
+	}
+
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Reload re-reads the list files, the current lists are kept on error
+func (c *Checker) Reload() error {
+	reserved := parseList(defaultReservedHandles)
+
+	if c.reservedHandlesPath != "" {
+		data, err := os.ReadFile(c.reservedHandlesPath)
+		if err != nil {
+			return err
+		}
+
+		reserved = append(reserved, parseList(data)...)
+	}
+
+	profanity := profanityList{words: make(map[string]struct{})}
+
+	if c.profanityListPath != "" {
+		data, err := os.ReadFile(c.profanityListPath)
+		if err != nil {
+			return err
+		}
+
+		for _, word := range parseList(data) {
+			substring := strings.HasPrefix(word, "*")
+
+			if word = normalize(word); word == "" {
+				continue
+			}
+
+			if substring {
+				profanity.substrings = append(profanity.substrings, word)
+			} else {
+				profanity.words[word] = struct{}{}
+			}
+		}
+	}
+
+	reservedHandles := make(map[string]struct{}, len(reserved))
+	for _, handle := range reserved {
+		reservedHandles[handle] = struct{}{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reservedHandles = reservedHandles
+	c.profanity = profanity
+
+	return nil
+}
+
+// IsReserved reports whether the handle is reserved
+func (c *Checker) IsReserved(handle string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.reservedHandles[strings.ToLower(handle)]
+	return ok
+}
+
+// IsAllowed reports whether the handle or display name is free of words in
+// the profanity list. Names are split into tokens on spaces, underscores and
+// dashes, and each token is compared with only its letters lowercased, so
+// "B.a.D guy" matches "bad" while "Badminton" doesn't. Words marked with a *
+// in the list match anywhere in the name instead
+func (c *Checker) IsAllowed(name string) bool {
+	tokens := tokenize(name)
+	normalized := normalize(name)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, token := range tokens {
+		if _, ok := c.profanity.words[token]; ok {
+			return false
+		}
+	}
+
+	for _, word := range c.profanity.substrings {
+		if strings.Contains(normalized, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseList parses one lowercased entry per line, skipping blank lines and
+// # comments
+func parseList(data []byte) []string {
+	var entries []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entries = append(entries, strings.ToLower(line))
+	}
+
+	return entries
+}
+
+// tokenize splits s into normalized tokens, skipping the ones without letters
+func tokenize(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '_' || r == '-'
+	})
+
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if token := normalize(field); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
+
+// normalize keeps only the letters of s, lowercased
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) {
+			return -1
+		}
+
+		return unicode.ToLower(r)
+	}, s)
+}
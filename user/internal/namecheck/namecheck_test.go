@@ -0,0 +1,120 @@
+package namecheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeList(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "list.txt")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestIsReserved(t *testing.T) {
+	c, err := New(writeList(t, "# extra handles\n\nStaff\n"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]bool{
+		"admin":  true,
+		"ADMIN":  true,
+		"twirp":  true,
+		"staff":  true,
+		"alice":  false,
+		"admins": false,
+		"":       false,
+	}
+
+	for handle, want := range tests {
+		if got := c.IsReserved(handle); got != want {
+			t.Errorf("IsReserved(%q) = %t, want %t", handle, got, want)
+		}
+	}
+}
+
+func TestIsAllowed(t *testing.T) {
+	c, err := New("", writeList(t, "# words\nass\nBad\n*slur\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]bool{
+		"":                  true,
+		"Alice":             true,
+		"Cassandra":         true,
+		"Cassandra the 2nd": true,
+		"Badminton":         true,
+		"ass":               false,
+		"Kick Ass":          false,
+		"bad_guy":           false,
+		"very-bad":          false,
+		"B.a.D":             false,
+		"BAD":               false,
+		"superslurs":        false,
+		"Super Slur":        false,
+		// * words ignore token boundaries
+		"s l u r": false,
+	}
+
+	for name, want := range tests {
+		if got := c.IsAllowed(name); got != want {
+			t.Errorf("IsAllowed(%q) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestIsAllowedWithoutList(t *testing.T) {
+	c, err := New("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.IsAllowed("anything goes") {
+		t.Error("names are rejected without a profanity list")
+	}
+}
+
+func TestReload(t *testing.T) {
+	path := writeList(t, "bad\n")
+
+	c, err := New("", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.IsAllowed("bad") {
+		t.Fatal("bad is allowed before the reload")
+	}
+
+	if err := os.WriteFile(path, []byte("worse\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.IsAllowed("bad") || c.IsAllowed("worse") {
+		t.Error("the reload didn't replace the list")
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Reload(); err == nil {
+		t.Fatal("reloading a missing list succeeded")
+	}
+
+	if c.IsAllowed("worse") {
+		t.Error("a failed reload dropped the current list")
+	}
+}
@@ -0,0 +1,88 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor is returned when a cursor can't be decoded or its
+// signature doesn't match
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the keyset position of the last item of a page, ordered by
+// a timestamp with the id as a tie-breaker. Pages ranked by a score first
+// also carry the score of the last item
+type Cursor struct {
+	Score int64
+	Time  time.Time
+	ID    string
+}
+
+type cursorPayload struct {
+	Score int64  `json:"s,omitempty"`
+	Time  int64  `json:"t"`
+	ID    string `json:"id"`
+}
+
+// Codec encodes cursors into opaque signed tokens so clients can't forge
+// arbitrary keyset values
+type Codec struct {
+	secret []byte
+}
+
+// NewCodec creates a codec signing cursors with secret
+func NewCodec(secret []byte) *Codec {
+	return &Codec{secret: secret}
+}
+
+// Encode encodes the cursor into a token of the form payload.signature
+func (c *Codec) Encode(cursor Cursor) string {
+	payload, _ := json.Marshal(cursorPayload{
+		Score: cursor.Score,
+		Time:  cursor.Time.UnixNano(),
+		ID:    cursor.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+// Decode decodes a token created by Encode, timestamps are returned in UTC
+func (c *Codec) Decode(token string) (Cursor, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(payload)) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var p cursorPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if p.Time <= 0 || p.ID == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{Score: p.Score, Time: time.Unix(0, p.Time).UTC(), ID: p.ID}, nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,65 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	cursor := Cursor{
+		Score: 42,
+		Time:  time.Date(2022, 10, 1, 12, 30, 15, 123456789, time.FixedZone("WIB", 7*60*60)),
+		ID:    "6f1c6b9e-4a2b-4f55-9a57-55b2f8f1d1a0",
+	}
+
+	decoded, err := codec.Decode(codec.Encode(cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Score != cursor.Score || !decoded.Time.Equal(cursor.Time) || decoded.ID != cursor.ID {
+		t.Errorf("decoded %+v, want %+v", decoded, cursor)
+	}
+
+	if decoded.Time.Location() != time.UTC {
+		t.Errorf("decoded time is in %s, want UTC", decoded.Time.Location())
+	}
+}
+
+func TestCodecDecodeInvalid(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	token := codec.Encode(Cursor{Time: time.Now(), ID: "id"})
+	payload, signature, _ := strings.Cut(token, ".")
+
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"t":1,"id":"other"}`))
+
+	tests := map[string]string{
+		"empty":                "",
+		"missing separator":    payload + signature,
+		"tampered payload":     forgedPayload + "." + signature,
+		"tampered signature":   payload + "." + base64.RawURLEncoding.EncodeToString([]byte("not the signature")),
+		"payload not base64":   "!!!." + signature,
+		"signature not base64": payload + ".!!!",
+		"other secret":         NewCodec([]byte("other")).Encode(Cursor{Time: time.Now(), ID: "id"}),
+		"zero time":            codec.Encode(Cursor{ID: "id"}),
+		"empty id":             codec.Encode(Cursor{Time: time.Now()}),
+		"signed non json":      signedToken(codec, []byte("not json")),
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := codec.Decode(token); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("Decode(%q) error = %v, want ErrInvalidCursor", token, err)
+			}
+		})
+	}
+}
+
+func signedToken(codec *Codec, payload []byte) string {
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(codec.sign(payload))
+}
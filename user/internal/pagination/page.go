@@ -0,0 +1,63 @@
+package pagination
+
+import (
+	"github.com/Masterminds/squirrel"
+)
+
+// Page is the envelope of a paginated result
+type Page[T any] struct {
+	Items []T
+	// NextCursor is empty when there are no more pages
+	NextCursor string
+}
+
+// ClampLimit returns defaultLimit for non-positive limits and caps the rest
+// at maxLimit
+func ClampLimit(limit, defaultLimit, maxLimit int) int {
+	if limit <= 0 {
+		return defaultLimit
+	}
+
+	if limit > maxLimit {
+		return maxLimit
+	}
+
+	return limit
+}
+
+// NewPage builds a page out of items queried with a limit of limit+1, the
+// extra item only tells whether a next page exists and is dropped
+func NewPage[T any](codec *Codec, items []T, limit int, cursorOf func(T) Cursor) Page[T] {
+	if len(items) <= limit {
+		return Page[T]{Items: items}
+	}
+
+	items = items[:limit]
+
+	return Page[T]{
+		Items:      items,
+		NextCursor: codec.Encode(cursorOf(items[len(items)-1])),
+	}
+}
+
+// Map converts the items of a page, keeping its cursor
+func Map[T, U any](page Page[T], fn func(T) U) Page[U] {
+	items := make([]U, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = fn(item)
+	}
+
+	return Page[U]{Items: items, NextCursor: page.NextCursor}
+}
+
+// Before is the keyset condition selecting the rows after cursor in a
+// (timeColumn DESC, idColumn DESC) ordering
+func Before(timeColumn, idColumn string, cursor Cursor) squirrel.Sqlizer {
+	return squirrel.Expr("("+timeColumn+", "+idColumn+") < (?, ?)", cursor.Time, cursor.ID)
+}
+
+// BeforeScored is the keyset condition selecting the rows after cursor in a
+// (scoreColumn DESC, timeColumn DESC, idColumn DESC) ordering
+func BeforeScored(scoreColumn, timeColumn, idColumn string, cursor Cursor) squirrel.Sqlizer {
+	return squirrel.Expr("("+scoreColumn+", "+timeColumn+", "+idColumn+") < (?, ?, ?)", cursor.Score, cursor.Time, cursor.ID)
+}
@@ -0,0 +1,125 @@
+package pagination
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClampLimit(t *testing.T) {
+	tests := []struct {
+		limit, want int
+	}{
+		{limit: -1, want: 20},
+		{limit: 0, want: 20},
+		{limit: 1, want: 1},
+		{limit: 99, want: 99},
+		{limit: 100, want: 100},
+		{limit: 101, want: 100},
+	}
+
+	for _, tt := range tests {
+		if got := ClampLimit(tt.limit, 20, 100); got != tt.want {
+			t.Errorf("ClampLimit(%d, 20, 100) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
+
+type item struct {
+	at time.Time
+	id string
+}
+
+func items(n int) []item {
+	base := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	result := make([]item, n)
+	for i := range result {
+		result[i] = item{at: base.Add(-time.Duration(i) * time.Minute), id: string(rune('a' + i))}
+	}
+
+	return result
+}
+
+func cursorOf(i item) Cursor {
+	return Cursor{Time: i.at, ID: i.id}
+}
+
+func TestNewPageTrimsProbeRow(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+
+	// Queried with a limit of 3+1, the fourth row only proves there is more
+	queried := items(4)
+	page := NewPage(codec, queried, 3, cursorOf)
+
+	if !reflect.DeepEqual(page.Items, queried[:3]) {
+		t.Errorf("items = %v, want the first 3", page.Items)
+	}
+
+	cursor, err := codec.Decode(page.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := cursorOf(queried[2]); !cursor.Time.Equal(want.Time) || cursor.ID != want.ID {
+		t.Errorf("next cursor = %+v, want the last returned item %+v", cursor, want)
+	}
+}
+
+func TestNewPageLastPage(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+
+	for _, n := range []int{0, 2, 3} {
+		page := NewPage(codec, items(n), 3, cursorOf)
+
+		if len(page.Items) != n {
+			t.Errorf("%d rows: got %d items", n, len(page.Items))
+		}
+
+		if page.NextCursor != "" {
+			t.Errorf("%d rows: next cursor = %q, want none on the last page", n, page.NextCursor)
+		}
+	}
+}
+
+func TestMapKeepsCursor(t *testing.T) {
+	page := Map(Page[int]{Items: []int{1, 2}, NextCursor: "next"}, func(i int) int { return i * 10 })
+
+	if !reflect.DeepEqual(page.Items, []int{10, 20}) || page.NextCursor != "next" {
+		t.Errorf("Map = %+v", page)
+	}
+}
+
+func TestBefore(t *testing.T) {
+	at := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	sql, args, err := Before("f.created_at", "u.id", Cursor{Time: at, ID: "id"}).ToSql()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "(f.created_at, u.id) < (?, ?)"; sql != want {
+		t.Errorf("sql = %q, want %q", sql, want)
+	}
+
+	if !reflect.DeepEqual(args, []any{at, "id"}) {
+		t.Errorf("args = %v", args)
+	}
+}
+
+func TestBeforeScored(t *testing.T) {
+	at := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	sql, args, err := BeforeScored("e.score", "e.created_at", "e.id", Cursor{Score: 3, Time: at, ID: "id"}).ToSql()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "(e.score, e.created_at, e.id) < (?, ?, ?)"; sql != want {
+		t.Errorf("sql = %q, want %q", sql, want)
+	}
+
+	if !reflect.DeepEqual(args, []any{int64(3), at, "id"}) {
+		t.Errorf("args = %v", args)
+	}
+}
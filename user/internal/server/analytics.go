@@ -0,0 +1,66 @@
+package server
+
+import (
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+)
+
+const dateLayout = "2006-01-02"
+
+// validateAnalyticsRequest checks the fields shared by the analytics
+// requests and that the requester asks for their own analytics
+func validateAnalyticsRequest(userID, requesterID, from, to string) error {
+	if userID == "" {
+		return twirp.RequiredArgumentError("user_id")
+	}
+
+	parsedUserID, err := uuid.Parse(userID)
+	if err != nil {
+		return twirp.InvalidArgumentError("user_id", "must be a uuid")
+	}
+
+	if requesterID == "" {
+		return twirp.RequiredArgumentError("requester_id")
+	}
+
+	parsedRequesterID, err := uuid.Parse(requesterID)
+	if err != nil {
+		return twirp.InvalidArgumentError("requester_id", "must be a uuid")
+	}
+
+	if parsedRequesterID != parsedUserID {
+		return twirp.NewError(twirp.PermissionDenied, "users can only see their own analytics")
+	}
+
+	if _, err := parseAnalyticsPeriod(from, to); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseAnalyticsPeriod parses the optional YYYY-MM-DD dates of an analytics
+// request
+func parseAnalyticsPeriod(from, to string) (service.AnalyticsPeriod, error) {
+	var (
+		period service.AnalyticsPeriod
+		err    error
+	)
+
+	if from != "" {
+		if period.From, err = time.Parse(dateLayout, from); err != nil {
+			return service.AnalyticsPeriod{}, twirp.InvalidArgumentError("from", "must be formatted as YYYY-MM-DD")
+		}
+	}
+
+	if to != "" {
+		if period.To, err = time.Parse(dateLayout, to); err != nil {
+			return service.AnalyticsPeriod{}, twirp.InvalidArgumentError("to", "must be formatted as YYYY-MM-DD")
+		}
+	}
+
+	return period, nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+)
+
+// maxAutocompleteQueryLength limits how long a typed mention query can be
+const maxAutocompleteQueryLength = 50
+
+func (h *handler) AutocompleteUsers(ctx context.Context, req *user.AutocompleteUsersRequest) (*user.AutocompleteUsersResponse, error) {
+	if err := validateAutocompleteUsersRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	users, err := h.service.AutocompleteUsers(ctx, service.AutocompleteUsersParams{
+		ViewerID: req.GetViewerId(),
+		Query:    req.GetQuery(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	summaries := make([]*user.UserSummary, len(users))
+	for i, u := range users {
+		summaries[i] = u.SummaryPB()
+	}
+
+	return &user.AutocompleteUsersResponse{
+		Users: summaries,
+	}, nil
+}
+
+func validateAutocompleteUsersRequest(ctx context.Context, req *user.AutocompleteUsersRequest) error {
+	if req.GetViewerId() == "" {
+		return twirp.RequiredArgumentError("viewer_id")
+	}
+
+	if _, err := uuid.Parse(req.GetViewerId()); err != nil {
+		return twirp.InvalidArgumentError("viewer_id", "must be a uuid")
+	}
+
+	if utf8.RuneCountInString(req.GetQuery()) > maxAutocompleteQueryLength {
+		return twirp.InvalidArgumentError("query", fmt.Sprintf("must not be longer than %d characters", maxAutocompleteQueryLength))
+	}
+
+	if req.GetLimit() < 0 {
+		return twirp.InvalidArgumentError("limit", "must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func TestValidateAutocompleteUsersRequest(t *testing.T) {
+	tests := map[string]*user.AutocompleteUsersRequest{
+		"no viewer":        {Query: "jo"},
+		"malformed viewer": {ViewerId: "bob", Query: "jo"},
+		"negative limit":   {ViewerId: bob, Query: "jo", Limit: -1},
+	}
+
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateAutocompleteUsersRequest(context.Background(), req)
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument {
+				t.Errorf("err = %v, want %s", err, twirp.InvalidArgument)
+			}
+		})
+	}
+
+	if err := validateAutocompleteUsersRequest(context.Background(), &user.AutocompleteUsersRequest{ViewerId: bob, Query: "jo"}); err != nil {
+		t.Errorf("valid request: %v", err)
+	}
+}
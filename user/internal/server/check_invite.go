@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) CheckInvite(ctx context.Context, req *user.CheckInviteRequest) (*user.CheckInviteResponse, error) {
+	err := h.service.CheckInvite(ctx, req.GetInviteToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRegistrationClosed), errors.Is(err, service.ErrInvalidInvite):
+			return nil, inviteError(err)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &user.CheckInviteResponse{}, nil
+}
+
+// inviteError reports a refused invite token. Closed registration stays a
+// permission_denied error, with invite_token set to invite_required in its
+// meta, and a token that can't be used is an invite_token=invite_invalid
+// field error, so the signup form can tell them apart from any other error
+func inviteError(err error) error {
+	if errors.Is(err, service.ErrRegistrationClosed) {
+		return twirp.NewError(twirp.PermissionDenied, "registration is closed, an invite is required to sign up").
+			WithMeta("invite_token", "invite_required")
+	}
+
+	return fieldErrors{"invite_token": "invite_invalid"}.err()
+}
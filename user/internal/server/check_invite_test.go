@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+// inviteService refuses every invite token with err
+type inviteService struct {
+	fakeService
+	err error
+}
+
+func (s *inviteService) CheckInvite(ctx context.Context, inviteToken string) error {
+	return s.err
+}
+
+func TestCheckInviteErrors(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		wantCode twirp.ErrorCode
+		wantMeta string
+	}{
+		"usable":              {},
+		"registration closed": {err: service.ErrRegistrationClosed, wantCode: twirp.PermissionDenied, wantMeta: "invite_required"},
+		"invalid invite":      {err: service.ErrInvalidInvite, wantCode: twirp.InvalidArgument, wantMeta: "invite_invalid"},
+		"other error":         {err: errors.New("boom"), wantCode: twirp.Internal},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := &handler{service: &inviteService{err: tt.err}}
+
+			_, err := h.CheckInvite(context.Background(), &user.CheckInviteRequest{InviteToken: "CODE"})
+
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != tt.wantCode {
+				t.Fatalf("err = %v, want %s", err, tt.wantCode)
+			}
+
+			if got := twerr.Meta("invite_token"); got != tt.wantMeta {
+				t.Errorf("invite_token meta = %q, want %q", got, tt.wantMeta)
+			}
+		})
+	}
+}
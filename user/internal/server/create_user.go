@@ -2,22 +2,20 @@ package server
 
 import (
 	"context"
+	"errors"
+	"net/mail"
 
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
 	"github.com/HotPotatoC/twitter-clone/user/internal/service"
 	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
 	"github.com/twitchtv/twirp"
 )
 
 func (h *handler) CreateUser(ctx context.Context, req *user.CreateUserRequest) (*user.CreateUserResponse, error) {
-	if err := validateCreateUserRequest(ctx, req); err != nil {
+	if err := h.validateCreateUserRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
-	_, err := h.service.FindUserByEmail(ctx, req.GetEmail())
-	if err == nil {
-		return nil, twirp.NewError(twirp.AlreadyExists, "email already exists")
-	}
-
 	createdUser, err := h.service.CreateUser(ctx, service.CreateUserParams{
 		Name:             req.GetName(),
 		ScreenName:       req.GetScreenName(),
@@ -29,9 +27,18 @@ func (h *handler) CreateUser(ctx context.Context, req *user.CreateUserRequest) (
 		ProfileImageURL:  req.GetProfileImageUrl(),
 		ProfileBannerURL: req.GetProfileBannerUrl(),
 		BirthDate:        req.GetBirthDate().AsTime(),
+		InviteToken:      req.GetInviteToken(),
+		Timezone:         req.GetTimezone(),
 	})
 	if err != nil {
-		return nil, twirp.InternalErrorWith(err)
+		switch {
+		case errors.Is(err, service.ErrEmailTaken):
+			return nil, twirp.NewError(twirp.AlreadyExists, "email already exists")
+		case errors.Is(err, service.ErrRegistrationClosed), errors.Is(err, service.ErrInvalidInvite):
+			return nil, inviteError(err)
+		default:
+			return nil, internalError(err)
+		}
 	}
 
 	return &user.CreateUserResponse{
@@ -39,22 +46,41 @@ func (h *handler) CreateUser(ctx context.Context, req *user.CreateUserRequest) (
 	}, nil
 }
 
-func validateCreateUserRequest(ctx context.Context, req *user.CreateUserRequest) error {
+// validateCreateUserRequest checks the signup fields. Reserved handles and
+// names on the profanity list are reported as handle_reserved and
+// name_not_allowed in the meta of a 400 invalid_argument error, not a 422
+func (h *handler) validateCreateUserRequest(ctx context.Context, req *user.CreateUserRequest) error {
+	errs := fieldErrors{}
+
 	if req.GetName() == "" {
-		return twirp.RequiredArgumentError("name")
+		errs.add("name", "required")
+	} else if !h.names.IsAllowed(req.GetName()) {
+		errs.add("name", "name_not_allowed")
 	}
 
 	if req.GetScreenName() == "" {
-		return twirp.RequiredArgumentError("screen_name")
+		errs.add("screen_name", "required")
+	} else if h.names.IsReserved(req.GetScreenName()) {
+		errs.add("screen_name", "handle_reserved")
+	} else if !h.names.IsAllowed(req.GetScreenName()) {
+		errs.add("screen_name", "name_not_allowed")
 	}
 
 	if req.GetPassword() == "" {
-		return twirp.RequiredArgumentError("email")
+		errs.add("password", "required")
+	} else if err := models.Password(req.GetPassword()).Validate(); err != nil {
+		errs.add("password", "invalid_characters")
 	}
 
 	if req.GetEmail() == "" {
-		return twirp.RequiredArgumentError("email")
+		errs.add("email", "required")
+	} else if _, err := mail.ParseAddress(req.GetEmail()); err != nil {
+		errs.add("email", "invalid")
+	}
+
+	if req.GetTimezone() != "" && !models.ValidTimezone(req.GetTimezone()) {
+		errs.add("timezone", "invalid")
 	}
 
-	return nil
+	return errs.err()
 }
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/namecheck"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func TestValidateCreateUserRequest(t *testing.T) {
+	names, err := namecheck.New("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := &handler{names: names}
+
+	valid := func() *user.CreateUserRequest {
+		return &user.CreateUserRequest{
+			Name:       "Alice",
+			ScreenName: "alice",
+			Email:      "alice@example.com",
+			Password:   "hunter22",
+		}
+	}
+
+	tests := map[string]struct {
+		modify   func(req *user.CreateUserRequest)
+		wantMeta map[string]string
+	}{
+		"valid": {
+			modify: func(req *user.CreateUserRequest) {},
+		},
+		"every field": {
+			modify: func(req *user.CreateUserRequest) {
+				req.Name = ""
+				req.ScreenName = "admin"
+				req.Email = "not an email"
+				req.Password = "pässword"
+				req.Timezone = "Mars/Olympus_Mons"
+			},
+			wantMeta: map[string]string{
+				"name":        "required",
+				"screen_name": "handle_reserved",
+				"email":       "invalid",
+				"password":    "invalid_characters",
+				"timezone":    "invalid",
+			},
+		},
+		"missing": {
+			modify: func(req *user.CreateUserRequest) {
+				req.Email = ""
+				req.Password = ""
+			},
+			wantMeta: map[string]string{
+				"email":    "required",
+				"password": "required",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+
+			err := h.validateCreateUserRequest(context.Background(), req)
+
+			if tt.wantMeta == nil {
+				if err != nil {
+					t.Fatalf("err = %v, want nil", err)
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument {
+				t.Fatalf("err = %v, want invalid_argument", err)
+			}
+
+			meta := twerr.MetaMap()
+			if len(meta) != len(tt.wantMeta) {
+				t.Errorf("meta = %v, want %v", meta, tt.wantMeta)
+			}
+
+			for field, msg := range tt.wantMeta {
+				if meta[field] != msg {
+					t.Errorf("meta[%s] = %q, want %q", field, meta[field], msg)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/identicon"
+	"github.com/HotPotatoC/twitter-clone/user/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// defaultAvatarRoute serves the avatars of models.DefaultProfileImagePath
+const defaultAvatarRoute = "/avatars/default/{userID}.png"
+
+// defaultAvatarHandler serves the generated identicon of a user, the images
+// never change so they can be cached forever
+func defaultAvatarHandler(avatars *identicon.Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID := chi.URLParam(r, "userID")
+
+		parsed, err := uuid.Parse(userID)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		// Hash the canonical form so every spelling of the id gets the same
+		// image and cache entry
+		img, err := avatars.Get(parsed.String())
+		if err != nil {
+			logger.M.Errorf("failed to generate default avatar: %v", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Write(img)
+	}
+}
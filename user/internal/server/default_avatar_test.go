@@ -0,0 +1,64 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func getAvatar(t *testing.T, url string) (int, []byte) {
+	t.Helper()
+
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res.StatusCode, data
+}
+
+func TestDefaultAvatarIDSpellings(t *testing.T) {
+	ts := newTestServer(t, testConfig(0), fakeService{})
+
+	id := "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+
+	status, want := getAvatar(t, ts.URL+"/avatars/default/"+id+".png")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d, want 200", status)
+	}
+
+	spellings := []string{
+		strings.ToUpper(id),
+		"{" + id + "}",
+		"urn:uuid:" + id,
+		strings.ReplaceAll(id, "-", ""),
+	}
+
+	for _, spelling := range spellings {
+		status, got := getAvatar(t, ts.URL+"/avatars/default/"+spelling+".png")
+		if status != http.StatusOK {
+			t.Errorf("%s: status = %d, want 200", spelling, status)
+			continue
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s got a different image than %s", spelling, id)
+		}
+	}
+}
+
+func TestDefaultAvatarInvalidID(t *testing.T) {
+	ts := newTestServer(t, testConfig(0), fakeService{})
+
+	if status, _ := getAvatar(t, ts.URL+"/avatars/default/nope.png"); status != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", status)
+	}
+}
@@ -21,7 +21,7 @@ func (h *handler) DeleteUser(ctx context.Context, req *user.DeleteUserRequest) (
 		case errors.Is(err, pgx.ErrNoRows):
 			return &user.DeleteUserResponse{Success: false}, twirp.NotFoundError(fmt.Sprintf("User with id %s does not exists", req.GetUserId()))
 		default:
-			return &user.DeleteUserResponse{Success: false}, twirp.InternalErrorWith(err)
+			return &user.DeleteUserResponse{Success: false}, internalError(err)
 		}
 	}
 
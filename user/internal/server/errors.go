@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/twitchtv/twirp"
+)
+
+// queryCanceled is the SQLSTATE reported when a statement is cancelled,
+// including cancellations caused by statement_timeout.
+const queryCanceled = "57014"
+
+// internalError converts an unexpected service error into a twirp error.
+// Queries aborted by the database statement_timeout are reported as
+// unavailable so clients know the request can be retried, requests that ran
+// out of their time budget are reported as deadline exceeded. Twirp has no
+// code for a 504 Gateway Timeout, deadline exceeded is sent as a 408.
+func internalError(err error) twirp.Error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return twirp.NewError(twirp.DeadlineExceeded, "the request took too long to process")
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == queryCanceled {
+		return twirp.NewError(twirp.Unavailable, "the request took too long to process, please try again")
+	}
+
+	return twirp.InternalErrorWith(err)
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/clients/postgres"
+	"github.com/jackc/pgconn"
+	"github.com/twitchtv/twirp"
+)
+
+func TestInternalErrorStatementTimeout(t *testing.T) {
+	err := fmt.Errorf("finding user: %w", &pgconn.PgError{Code: queryCanceled})
+
+	twerr := internalError(err)
+
+	if twerr.Code() != twirp.Unavailable {
+		t.Errorf("code = %s, want %s", twerr.Code(), twirp.Unavailable)
+	}
+
+	if status := twirp.ServerHTTPStatusFromErrorCode(twerr.Code()); status != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", status)
+	}
+}
+
+func TestInternalErrorOtherErrors(t *testing.T) {
+	for _, err := range []error{errors.New("boom"), &pgconn.PgError{Code: "23505"}} {
+		if code := internalError(err).Code(); code != twirp.Internal {
+			t.Errorf("internalError(%v) code = %s, want %s", err, code, twirp.Internal)
+		}
+	}
+}
+
+// TestStatementTimeoutFires runs a query outliving DB_STATEMENT_TIMEOUT
+// against the database in TEST_DATABASE_URL, skipped when it is not set
+func TestStatementTimeoutFires(t *testing.T) {
+	connString := os.Getenv("TEST_DATABASE_URL")
+	if connString == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+
+	db, err := postgres.NewPostgreSQLClient(ctx, connString, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(ctx, "SELECT pg_sleep(1)")
+	if err == nil {
+		t.Fatal("pg_sleep(1) finished despite the 100ms statement_timeout")
+	}
+
+	twerr := internalError(err)
+	if status := twirp.ServerHTTPStatusFromErrorCode(twerr.Code()); status != http.StatusServiceUnavailable {
+		t.Errorf("%v mapped to %s (%d), want 503", err, twerr.Code(), status)
+	}
+}
+
+func TestInternalErrorDeadlineExceeded(t *testing.T) {
+	err := fmt.Errorf("finding user: %w", context.DeadlineExceeded)
+
+	twerr := internalError(err)
+
+	if twerr.Code() != twirp.DeadlineExceeded {
+		t.Errorf("code = %s, want %s", twerr.Code(), twirp.DeadlineExceeded)
+	}
+
+	if status := twirp.ServerHTTPStatusFromErrorCode(twerr.Code()); status != http.StatusRequestTimeout {
+		t.Errorf("status = %d, want 408", status)
+	}
+}
@@ -21,7 +21,7 @@ func (h *handler) FindUserByEmail(ctx context.Context, req *user.FindUserByEmail
 		case errors.Is(err, pgx.ErrNoRows):
 			return nil, twirp.NotFoundError(fmt.Sprintf("User with email %s does not exists", req.GetEmail()))
 		default:
-			return nil, twirp.InternalErrorWith(err)
+			return nil, internalError(err)
 		}
 	}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
 	"github.com/twitchtv/twirp"
 )
@@ -21,12 +22,22 @@ func (h *handler) FindUserByID(ctx context.Context, req *user.FindUserByIDReques
 		case errors.Is(err, pgx.ErrNoRows):
 			return nil, twirp.NotFoundError(fmt.Sprintf("User with id %s does not exists", req.GetUserId()))
 		default:
-			return nil, twirp.InternalErrorWith(err)
+			return nil, internalError(err)
+		}
+	}
+
+	var followsYou bool
+
+	if req.GetViewerId() != "" && req.GetViewerId() != userData.ID {
+		followsYou, err = h.service.IsFollowing(ctx, userData.ID, req.GetViewerId())
+		if err != nil {
+			return nil, internalError(err)
 		}
 	}
 
 	return &user.FindUserByIDResponse{
-		User: userData.PB(),
+		User:       userData.PB(),
+		FollowsYou: followsYou,
 	}, nil
 }
 
@@ -35,5 +46,12 @@ func validateFindUserByIDRequest(ctx context.Context, req *user.FindUserByIDRequ
 		return twirp.RequiredArgumentError("user_id")
 	}
 
+	// Malformed ids would make the database reject the follow lookup
+	if req.GetViewerId() != "" {
+		if _, err := uuid.Parse(req.GetViewerId()); err != nil {
+			return twirp.InvalidArgumentError("viewer_id", "must be a uuid")
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+// followingService knows users by id and who follows whom
+type followingService struct {
+	fakeService
+	follows map[[2]string]bool // follower, followee
+}
+
+func (s *followingService) FindUserByID(ctx context.Context, id string) (models.User, error) {
+	return models.User{ID: id, ScreenName: id}, nil
+}
+
+func (s *followingService) IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error) {
+	return s.follows[[2]string{followerID, followeeID}], nil
+}
+
+const (
+	alice = "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+	bob   = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+)
+
+func TestFindUserByIDFollowsYou(t *testing.T) {
+	tests := map[string]struct {
+		follows        [][2]string
+		userID         string
+		viewerID       string
+		wantFollowsYou bool
+	}{
+		"mutual": {
+			follows:        [][2]string{{alice, bob}, {bob, alice}},
+			userID:         alice,
+			viewerID:       bob,
+			wantFollowsYou: true,
+		},
+		"user follows viewer": {
+			follows:        [][2]string{{alice, bob}},
+			userID:         alice,
+			viewerID:       bob,
+			wantFollowsYou: true,
+		},
+		"viewer follows user": {
+			follows:  [][2]string{{bob, alice}},
+			userID:   alice,
+			viewerID: bob,
+		},
+		"no follows": {
+			userID:   alice,
+			viewerID: bob,
+		},
+		"no viewer": {
+			follows: [][2]string{{alice, bob}},
+			userID:  alice,
+		},
+		"viewing yourself": {
+			follows:  [][2]string{{alice, alice}},
+			userID:   alice,
+			viewerID: alice,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &followingService{follows: make(map[[2]string]bool)}
+			for _, f := range tt.follows {
+				svc.follows[f] = true
+			}
+
+			h := &handler{service: svc}
+
+			res, err := h.FindUserByID(context.Background(), &user.FindUserByIDRequest{
+				UserId:   tt.userID,
+				ViewerId: tt.viewerID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.GetFollowsYou() != tt.wantFollowsYou {
+				t.Errorf("follows_you = %t, want %t", res.GetFollowsYou(), tt.wantFollowsYou)
+			}
+		})
+	}
+}
+
+func TestFindUserByIDMalformedViewer(t *testing.T) {
+	h := &handler{service: &followingService{}}
+
+	_, err := h.FindUserByID(context.Background(), &user.FindUserByIDRequest{
+		UserId:   alice,
+		ViewerId: "bob",
+	})
+
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument {
+		t.Errorf("err = %v, want %s", err, twirp.InvalidArgument)
+	}
+}
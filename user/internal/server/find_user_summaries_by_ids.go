@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+)
+
+// maxUserSummariesPerRequest limits how many users can be resolved at once
+const maxUserSummariesPerRequest = 100
+
+func (h *handler) FindUserSummariesByIDs(ctx context.Context, req *user.FindUserSummariesByIDsRequest) (*user.FindUserSummariesByIDsResponse, error) {
+	if err := validateFindUserSummariesByIDsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	users, err := h.service.FindUsersByIDs(ctx, req.GetUserIds())
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	summaries := make(map[string]*user.UserSummary, len(users))
+	for id, u := range users {
+		summaries[id] = u.SummaryPB()
+	}
+
+	return &user.FindUserSummariesByIDsResponse{
+		Users: summaries,
+	}, nil
+}
+
+func validateFindUserSummariesByIDsRequest(ctx context.Context, req *user.FindUserSummariesByIDsRequest) error {
+	if len(req.GetUserIds()) == 0 {
+		return twirp.RequiredArgumentError("user_ids")
+	}
+
+	if len(req.GetUserIds()) > maxUserSummariesPerRequest {
+		return twirp.InvalidArgumentError("user_ids", fmt.Sprintf("must not contain more than %d ids", maxUserSummariesPerRequest))
+	}
+
+	// A single malformed id would make the database reject the whole batch
+	for _, id := range req.GetUserIds() {
+		if _, err := uuid.Parse(id); err != nil {
+			return twirp.InvalidArgumentError("user_ids", fmt.Sprintf("must only contain uuids, got %q", id))
+		}
+	}
+
+	return nil
+}
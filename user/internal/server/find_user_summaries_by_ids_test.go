@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func TestValidateFindUserSummariesByIDsRequest(t *testing.T) {
+	valid := "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+
+	tests := map[string]struct {
+		ids     []string
+		wantErr bool
+	}{
+		"valid":      {ids: []string{valid, strings.ToUpper(valid)}},
+		"empty":      {ids: nil, wantErr: true},
+		"empty id":   {ids: []string{valid, ""}, wantErr: true},
+		"not a uuid": {ids: []string{valid, "nope"}, wantErr: true},
+		"too many":   {ids: make([]string, maxUserSummariesPerRequest+1), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateFindUserSummariesByIDsRequest(context.Background(), &user.FindUserSummariesByIDsRequest{UserIds: tt.ids})
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("error = %v, want nil", err)
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument {
+				t.Errorf("error = %v, want invalid_argument", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) GenerateInvite(ctx context.Context, req *user.GenerateInviteRequest) (*user.GenerateInviteResponse, error) {
+	if err := validateGenerateInviteRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	params := service.GenerateInviteParams{
+		InviterID: req.GetUserId(),
+		ActorID:   authenticatedUserID(ctx),
+		MaxUses:   int(req.GetMaxUses()),
+	}
+
+	if req.GetExpiresAt() != nil {
+		params.ExpiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	invite, err := h.service.GenerateInvite(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, twirp.NotFoundError(fmt.Sprintf("User with id %s does not exists", req.GetUserId()))
+		case errors.Is(err, service.ErrInviteNotAllowed):
+			return nil, twirp.NewError(twirp.PermissionDenied, "only admins can generate invites")
+		case errors.Is(err, service.ErrInviteMaxUsesTooHigh):
+			return nil, fieldErrors{"max_uses": "too_high"}.err()
+		case errors.Is(err, service.ErrInviteExpiryTooLate):
+			return nil, fieldErrors{"expires_at": "too_late"}.err()
+		case errors.Is(err, service.ErrInviteLimitReached):
+			return nil, twirp.NewError(twirp.ResourceExhausted, "too many invites generated, try again later")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &user.GenerateInviteResponse{
+		Invite: invite.PB(),
+	}, nil
+}
+
+func validateGenerateInviteRequest(ctx context.Context, req *user.GenerateInviteRequest) error {
+	errs := fieldErrors{}
+
+	if req.GetUserId() == "" {
+		errs.add("user_id", "required")
+	} else if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		errs.add("user_id", "invalid")
+	}
+
+	if req.GetMaxUses() < 0 {
+		errs.add("max_uses", "negative")
+	}
+
+	if req.GetExpiresAt() != nil {
+		if err := req.GetExpiresAt().CheckValid(); err != nil {
+			errs.add("expires_at", "invalid")
+		} else if !req.GetExpiresAt().AsTime().After(time.Now()) {
+			errs.add("expires_at", "in_past")
+		}
+	}
+
+	return errs.err()
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func TestValidateGenerateInviteRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *user.GenerateInviteRequest
+		wantMeta string
+	}{
+		"no user":        {req: &user.GenerateInviteRequest{}, wantMeta: "required"},
+		"malformed user": {req: &user.GenerateInviteRequest{UserId: "alice"}, wantMeta: "invalid"},
+		"valid":          {req: &user.GenerateInviteRequest{UserId: alice}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateGenerateInviteRequest(context.Background(), tt.req)
+
+			if tt.wantMeta == "" {
+				if err != nil {
+					t.Errorf("err = %v, want nil", err)
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument || twerr.Meta("user_id") != tt.wantMeta {
+				t.Errorf("err = %v, want invalid_argument with user_id=%s", err, tt.wantMeta)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package server
+
+import (
+	"context"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+)
+
+func (h *handler) GetConfig(ctx context.Context, req *user.GetConfigRequest) (*user.GetConfigResponse, error) {
+	return &user.GetConfigResponse{
+		RegistrationOpen: h.service.RegistrationOpen(),
+	}, nil
+}
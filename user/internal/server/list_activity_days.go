@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/twitchtv/twirp"
+)
+
+const (
+	defaultActivityWeeks = 26
+	maxActivityWeeks     = 53
+)
+
+func (h *handler) ListActivityDays(ctx context.Context, req *user.ListActivityDaysRequest) (*user.ListActivityDaysResponse, error) {
+	if err := validateListActivityDaysRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	weeks := int(req.GetWeeks())
+	if weeks == 0 {
+		weeks = defaultActivityWeeks
+	}
+
+	days, err := h.service.ListActivityDays(ctx, req.GetUserId(), weeks)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, twirp.NotFoundError(fmt.Sprintf("User with id %s does not exists", req.GetUserId()))
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	pbDays := make([]*user.ActivityDay, len(days))
+	for i, day := range days {
+		pbDays[i] = day.PB()
+	}
+
+	return &user.ListActivityDaysResponse{
+		Days: pbDays,
+	}, nil
+}
+
+func validateListActivityDaysRequest(ctx context.Context, req *user.ListActivityDaysRequest) error {
+	if req.GetUserId() == "" {
+		return twirp.RequiredArgumentError("user_id")
+	}
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return twirp.InvalidArgumentError("user_id", "must be a uuid")
+	}
+
+	if req.GetRequesterId() == "" {
+		return twirp.RequiredArgumentError("requester_id")
+	}
+
+	requesterID, err := uuid.Parse(req.GetRequesterId())
+	if err != nil {
+		return twirp.InvalidArgumentError("requester_id", "must be a uuid")
+	}
+
+	if req.GetWeeks() < 0 || req.GetWeeks() > maxActivityWeeks {
+		return twirp.InvalidArgumentError("weeks", fmt.Sprintf("must be between 0 and %d", maxActivityWeeks))
+	}
+
+	if requesterID != userID {
+		return twirp.NewError(twirp.PermissionDenied, "users can only list their own activity")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+// activityService has no activity for anyone
+type activityService struct {
+	fakeService
+}
+
+func (s *activityService) ListActivityDays(ctx context.Context, userID string, weeks int) ([]models.ActivityDay, error) {
+	return make([]models.ActivityDay, weeks*7), nil
+}
+
+func TestListActivityDaysRequester(t *testing.T) {
+	id := "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+
+	tests := map[string]struct {
+		requesterID string
+		wantCode    twirp.ErrorCode
+	}{
+		"the user":            {requesterID: id},
+		"the user uppercase":  {requesterID: strings.ToUpper(id)},
+		"someone else":        {requesterID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", wantCode: twirp.PermissionDenied},
+		"the user in braces":  {requesterID: "{" + id + "}"},
+		"the user as a urn":   {requesterID: "urn:uuid:" + id},
+		"no requester":        {wantCode: twirp.InvalidArgument},
+		"malformed requester": {requesterID: "me", wantCode: twirp.InvalidArgument},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := &handler{service: &activityService{}}
+
+			res, err := h.ListActivityDays(context.Background(), &user.ListActivityDaysRequest{
+				UserId:      id,
+				RequesterId: tt.requesterID,
+				Weeks:       1,
+			})
+
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(res.GetDays()) != 7 {
+					t.Errorf("got %d days, want 7", len(res.GetDays()))
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != tt.wantCode {
+				t.Errorf("err = %v, want %s", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) ListDailyStats(ctx context.Context, req *user.ListDailyStatsRequest) (*user.ListDailyStatsResponse, error) {
+	if err := validateListDailyStatsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	period, _ := parseAnalyticsPeriod(req.GetFrom(), req.GetTo())
+
+	days, err := h.service.ListDailyStats(ctx, service.ListDailyStatsParams{
+		RequesterID: authenticatedUserID(ctx),
+		Period:      period,
+		Timezone:    req.GetTimeZone(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrAdminRequired):
+			return nil, twirp.NewError(twirp.PermissionDenied, "only admins can see the daily stats")
+		case errors.Is(err, service.ErrInvalidPeriod):
+			return nil, invalidPeriodError()
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	pbDays := make([]*user.DailyStat, len(days))
+	for i, day := range days {
+		pbDays[i] = day.PB()
+	}
+
+	return &user.ListDailyStatsResponse{
+		Days: pbDays,
+	}, nil
+}
+
+func validateListDailyStatsRequest(ctx context.Context, req *user.ListDailyStatsRequest) error {
+	if _, err := parseAnalyticsPeriod(req.GetFrom(), req.GetTo()); err != nil {
+		return err
+	}
+
+	if req.GetTimeZone() != "" && !models.ValidTimezone(req.GetTimeZone()) {
+		return twirp.InvalidArgumentError("time_zone", "must be an IANA time zone name")
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+)
+
+// dailyStatsService only shows the stats to alice
+type dailyStatsService struct {
+	fakeService
+}
+
+func (s *dailyStatsService) ListDailyStats(ctx context.Context, params service.ListDailyStatsParams) ([]models.DailyStat, error) {
+	if params.RequesterID != alice {
+		return nil, service.ErrAdminRequired
+	}
+
+	return nil, nil
+}
+
+func TestListDailyStats(t *testing.T) {
+	cfg := testConfig(0)
+	cfg.App.GatewayToken = "gateway-token"
+
+	ts := newTestServer(t, cfg, &dailyStatsService{})
+
+	tests := map[string]struct {
+		body       string
+		headers    map[string]string
+		wantStatus int
+	}{
+		"anonymous": {
+			body:       `{}`,
+			wantStatus: http.StatusForbidden,
+		},
+		"admin id without the gateway token": {
+			body:       `{}`,
+			headers:    map[string]string{userIDHeader: alice},
+			wantStatus: http.StatusForbidden,
+		},
+		"authenticated user": {
+			body:       `{}`,
+			headers:    map[string]string{gatewayTokenHeader: "gateway-token", userIDHeader: bob},
+			wantStatus: http.StatusForbidden,
+		},
+		"authenticated admin": {
+			body:       `{"time_zone":"Europe/Berlin","from":"2026-03-01","to":"2026-03-31"}`,
+			headers:    map[string]string{gatewayTokenHeader: "gateway-token", userIDHeader: alice},
+			wantStatus: http.StatusOK,
+		},
+		"unknown time zone": {
+			body:       `{"time_zone":"Mars/Olympus"}`,
+			headers:    map[string]string{gatewayTokenHeader: "gateway-token", userIDHeader: alice},
+			wantStatus: http.StatusBadRequest,
+		},
+		"malformed date": {
+			body:       `{"from":"03/01/2026"}`,
+			headers:    map[string]string{gatewayTokenHeader: "gateway-token", userIDHeader: alice},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost,
+				ts.URL+"/twirp/hotpotatoc.twitter_clone.user.UserService/ListDailyStats",
+				strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/jackc/pgx/v4"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) ListFollowerGrowth(ctx context.Context, req *user.ListFollowerGrowthRequest) (*user.ListFollowerGrowthResponse, error) {
+	if err := validateListFollowerGrowthRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	period, _ := parseAnalyticsPeriod(req.GetFrom(), req.GetTo())
+
+	buckets, err := h.service.ListFollowerGrowth(ctx, service.ListFollowerGrowthParams{
+		UserID:   req.GetUserId(),
+		Period:   period,
+		Interval: req.GetInterval(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, twirp.NotFoundError(fmt.Sprintf("User with id %s does not exists", req.GetUserId()))
+		case errors.Is(err, service.ErrInvalidPeriod):
+			return nil, invalidPeriodError()
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	pbBuckets := make([]*user.FollowerGrowthBucket, len(buckets))
+	for i, bucket := range buckets {
+		pbBuckets[i] = bucket.PB()
+	}
+
+	return &user.ListFollowerGrowthResponse{
+		Buckets: pbBuckets,
+	}, nil
+}
+
+func validateListFollowerGrowthRequest(ctx context.Context, req *user.ListFollowerGrowthRequest) error {
+	if err := validateAnalyticsRequest(req.GetUserId(), req.GetRequesterId(), req.GetFrom(), req.GetTo()); err != nil {
+		return err
+	}
+
+	switch req.GetInterval() {
+	case "", service.IntervalDay, service.IntervalWeek, service.IntervalMonth:
+		return nil
+	default:
+		return twirp.InvalidArgumentError("interval", "must be day, week or month")
+	}
+}
+
+// invalidPeriodError reports a period ending before it starts or spanning
+// too many days
+func invalidPeriodError() twirp.Error {
+	return twirp.InvalidArgumentError("to", "must not be before from nor more than 366 days after it")
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+// analyticsService has a single follower growth bucket for anyone
+type analyticsService struct {
+	fakeService
+}
+
+func (s *analyticsService) ListFollowerGrowth(ctx context.Context, params service.ListFollowerGrowthParams) ([]models.FollowerGrowthBucket, error) {
+	if !params.Period.To.IsZero() && params.Period.To.Before(params.Period.From) {
+		return nil, service.ErrInvalidPeriod
+	}
+
+	return []models.FollowerGrowthBucket{{NewFollowers: 1, Followers: 1}}, nil
+}
+
+func TestListFollowerGrowthRequest(t *testing.T) {
+	id := "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+
+	tests := map[string]struct {
+		req      *user.ListFollowerGrowthRequest
+		wantCode twirp.ErrorCode
+	}{
+		"the user":           {req: &user.ListFollowerGrowthRequest{UserId: id, RequesterId: id}},
+		"the user uppercase": {req: &user.ListFollowerGrowthRequest{UserId: id, RequesterId: strings.ToUpper(id)}},
+		"a period by week": {
+			req: &user.ListFollowerGrowthRequest{UserId: id, RequesterId: id, From: "2022-09-01", To: "2022-10-01", Interval: "week"},
+		},
+		"someone else": {
+			req:      &user.ListFollowerGrowthRequest{UserId: id, RequesterId: "7c9e6679-7425-40de-944b-e07fc1f90ae7"},
+			wantCode: twirp.PermissionDenied,
+		},
+		"the user in braces": {req: &user.ListFollowerGrowthRequest{UserId: id, RequesterId: "{" + id + "}"}},
+		"no requester":       {req: &user.ListFollowerGrowthRequest{UserId: id}, wantCode: twirp.InvalidArgument},
+		"malformed requester": {
+			req:      &user.ListFollowerGrowthRequest{UserId: id, RequesterId: "me"},
+			wantCode: twirp.InvalidArgument,
+		},
+		"malformed user":   {req: &user.ListFollowerGrowthRequest{UserId: "nope", RequesterId: "nope"}, wantCode: twirp.InvalidArgument},
+		"malformed from":   {req: &user.ListFollowerGrowthRequest{UserId: id, RequesterId: id, From: "01/09/2022"}, wantCode: twirp.InvalidArgument},
+		"unknown interval": {req: &user.ListFollowerGrowthRequest{UserId: id, RequesterId: id, Interval: "year"}, wantCode: twirp.InvalidArgument},
+		"to before from": {
+			req:      &user.ListFollowerGrowthRequest{UserId: id, RequesterId: id, From: "2022-10-01", To: "2022-09-01"},
+			wantCode: twirp.InvalidArgument,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := &handler{service: &analyticsService{}}
+
+			res, err := h.ListFollowerGrowth(context.Background(), tt.req)
+
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(res.GetBuckets()) != 1 {
+					t.Errorf("got %d buckets, want 1", len(res.GetBuckets()))
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != tt.wantCode {
+				t.Errorf("err = %v, want %s", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) ListInvites(ctx context.Context, req *user.ListInvitesRequest) (*user.ListInvitesResponse, error) {
+	if err := validateListInvitesRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.ListInvites(ctx, service.ListInvitesParams{
+		// requester_id is deprecated, anyone could claim to be an admin with it
+		RequesterID: authenticatedUserID(ctx),
+		InviterID:   req.GetInviterId(),
+		Limit:       int(req.GetLimit()),
+		Cursor:      req.GetCursor(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrAdminRequired):
+			return nil, twirp.NewError(twirp.PermissionDenied, "only admins can list invites")
+		case errors.Is(err, pagination.ErrInvalidCursor):
+			return nil, twirp.InvalidArgumentError("cursor", "is invalid")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	invites := make([]*user.InviteCode, len(result.Items))
+	for i, invite := range result.Items {
+		invites[i] = invite.PB()
+	}
+
+	return &user.ListInvitesResponse{
+		Invites:    invites,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func validateListInvitesRequest(ctx context.Context, req *user.ListInvitesRequest) error {
+	if req.GetInviterId() != "" {
+		if _, err := uuid.Parse(req.GetInviterId()); err != nil {
+			return twirp.InvalidArgumentError("inviter_id", "must be a uuid")
+		}
+	}
+
+	if req.GetLimit() < 0 {
+		return twirp.InvalidArgumentError("limit", "must not be negative")
+	}
+
+	return nil
+}
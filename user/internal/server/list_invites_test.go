@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+// inviteListService only lets alice list invites
+type inviteListService struct {
+	fakeService
+}
+
+func (s *inviteListService) ListInvites(ctx context.Context, params service.ListInvitesParams) (pagination.Page[models.InviteCode], error) {
+	if params.RequesterID != alice {
+		return pagination.Page[models.InviteCode]{}, service.ErrAdminRequired
+	}
+
+	return pagination.Page[models.InviteCode]{}, nil
+}
+
+func TestListInvitesSpoofedAdmin(t *testing.T) {
+	cfg := testConfig(0)
+	cfg.App.GatewayToken = "gateway-token"
+
+	ts := newTestServer(t, cfg, &inviteListService{})
+
+	tests := map[string]struct {
+		headers    map[string]string
+		wantStatus int
+	}{
+		"admin id only in the body": {
+			wantStatus: http.StatusForbidden,
+		},
+		"admin id without the gateway token": {
+			headers:    map[string]string{userIDHeader: alice},
+			wantStatus: http.StatusForbidden,
+		},
+		"admin id with a wrong gateway token": {
+			headers:    map[string]string{gatewayTokenHeader: "guess", userIDHeader: alice},
+			wantStatus: http.StatusForbidden,
+		},
+		"authenticated admin": {
+			headers:    map[string]string{gatewayTokenHeader: "gateway-token", userIDHeader: strings.ToUpper(alice)},
+			wantStatus: http.StatusOK,
+		},
+		"authenticated user": {
+			headers:    map[string]string{gatewayTokenHeader: "gateway-token", userIDHeader: bob},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost,
+				ts.URL+"/twirp/hotpotatoc.twitter_clone.user.UserService/ListInvites",
+				strings.NewReader(`{"requester_id":"`+alice+`"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestValidateListInvitesRequest(t *testing.T) {
+	err := validateListInvitesRequest(context.Background(), &user.ListInvitesRequest{InviterId: "alice"})
+
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument {
+		t.Errorf("err = %v, want %s", err, twirp.InvalidArgument)
+	}
+
+	for _, inviterID := range []string{"", alice} {
+		if err := validateListInvitesRequest(context.Background(), &user.ListInvitesRequest{InviterId: inviterID}); err != nil {
+			t.Errorf("inviter_id %q: %v", inviterID, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) ListMutualFollows(ctx context.Context, req *user.ListMutualFollowsRequest) (*user.ListMutualFollowsResponse, error) {
+	if err := validateListMutualFollowsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.ListMutualFollows(ctx, service.ListMutualFollowsParams{
+		ViewerID: req.GetViewerId(),
+		UserID:   req.GetUserId(),
+		Limit:    int(req.GetLimit()),
+		Cursor:   req.GetCursor(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pagination.ErrInvalidCursor):
+			return nil, twirp.InvalidArgumentError("cursor", "is invalid")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	users := make([]*user.UserSummary, len(result.Items))
+	for i, u := range result.Items {
+		users[i] = u.SummaryPB()
+	}
+
+	return &user.ListMutualFollowsResponse{
+		Users:      users,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func validateListMutualFollowsRequest(ctx context.Context, req *user.ListMutualFollowsRequest) error {
+	if req.GetViewerId() == "" {
+		return twirp.RequiredArgumentError("viewer_id")
+	}
+
+	if _, err := uuid.Parse(req.GetViewerId()); err != nil {
+		return twirp.InvalidArgumentError("viewer_id", "must be a uuid")
+	}
+
+	if req.GetUserId() == "" {
+		return twirp.RequiredArgumentError("user_id")
+	}
+
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		return twirp.InvalidArgumentError("user_id", "must be a uuid")
+	}
+
+	if req.GetLimit() < 0 {
+		return twirp.InvalidArgumentError("limit", "must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/twitchtv/twirp"
+)
+
+func TestValidateListMutualFollowsRequest(t *testing.T) {
+	tests := map[string]*user.ListMutualFollowsRequest{
+		"no viewer":        {UserId: alice},
+		"malformed viewer": {ViewerId: "bob", UserId: alice},
+		"no user":          {ViewerId: bob},
+		"malformed user":   {ViewerId: bob, UserId: "alice"},
+		"negative limit":   {ViewerId: bob, UserId: alice, Limit: -1},
+	}
+
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateListMutualFollowsRequest(context.Background(), req)
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != twirp.InvalidArgument {
+				t.Errorf("err = %v, want %s", err, twirp.InvalidArgument)
+			}
+		})
+	}
+
+	if err := validateListMutualFollowsRequest(context.Background(), &user.ListMutualFollowsRequest{ViewerId: bob, UserId: alice}); err != nil {
+		t.Errorf("valid request: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/jackc/pgx/v4"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) ListTopTweets(ctx context.Context, req *user.ListTopTweetsRequest) (*user.ListTopTweetsResponse, error) {
+	if err := validateListTopTweetsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	period, _ := parseAnalyticsPeriod(req.GetFrom(), req.GetTo())
+
+	result, err := h.service.ListTopTweets(ctx, service.ListTopTweetsParams{
+		UserID: req.GetUserId(),
+		Period: period,
+		Limit:  int(req.GetLimit()),
+		Cursor: req.GetCursor(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, twirp.NotFoundError(fmt.Sprintf("User with id %s does not exists", req.GetUserId()))
+		case errors.Is(err, service.ErrInvalidPeriod):
+			return nil, invalidPeriodError()
+		case errors.Is(err, pagination.ErrInvalidCursor):
+			return nil, twirp.InvalidArgumentError("cursor", "is invalid")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	tweets := make([]*user.TopTweet, len(result.Items))
+	for i, tweet := range result.Items {
+		tweets[i] = tweet.PB()
+	}
+
+	return &user.ListTopTweetsResponse{
+		Tweets:     tweets,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func validateListTopTweetsRequest(ctx context.Context, req *user.ListTopTweetsRequest) error {
+	if err := validateAnalyticsRequest(req.GetUserId(), req.GetRequesterId(), req.GetFrom(), req.GetTo()); err != nil {
+		return err
+	}
+
+	if req.GetLimit() < 0 {
+		return twirp.InvalidArgumentError("limit", "must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/twitchtv/twirp"
+)
+
+func (h *handler) ListTweetEngagement(ctx context.Context, req *user.ListTweetEngagementRequest) (*user.ListTweetEngagementResponse, error) {
+	if err := validateListTweetEngagementRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	period, _ := parseAnalyticsPeriod(req.GetFrom(), req.GetTo())
+
+	days, err := h.service.ListTweetEngagement(ctx, service.ListTweetEngagementParams{
+		TweetID:     req.GetTweetId(),
+		RequesterID: req.GetRequesterId(),
+		Period:      period,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, twirp.NotFoundError(fmt.Sprintf("Tweet with id %s does not exists", req.GetTweetId()))
+		case errors.Is(err, service.ErrNotAuthor):
+			return nil, twirp.NewError(twirp.PermissionDenied, "users can only see the analytics of their own tweets")
+		case errors.Is(err, service.ErrInvalidPeriod):
+			return nil, invalidPeriodError()
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	pbDays := make([]*user.TweetEngagementDay, len(days))
+	for i, day := range days {
+		pbDays[i] = day.PB()
+	}
+
+	return &user.ListTweetEngagementResponse{
+		Days: pbDays,
+	}, nil
+}
+
+func validateListTweetEngagementRequest(ctx context.Context, req *user.ListTweetEngagementRequest) error {
+	if req.GetTweetId() == "" {
+		return twirp.RequiredArgumentError("tweet_id")
+	}
+
+	if _, err := uuid.Parse(req.GetTweetId()); err != nil {
+		return twirp.InvalidArgumentError("tweet_id", "must be a uuid")
+	}
+
+	if req.GetRequesterId() == "" {
+		return twirp.RequiredArgumentError("requester_id")
+	}
+
+	if _, err := uuid.Parse(req.GetRequesterId()); err != nil {
+		return twirp.InvalidArgumentError("requester_id", "must be a uuid")
+	}
+
+	if _, err := parseAnalyticsPeriod(req.GetFrom(), req.GetTo()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
+	"github.com/jackc/pgx/v4"
+	"github.com/twitchtv/twirp"
+)
+
+const testTweetID = "5b0f1c7e-2d4a-4e8b-9c3f-6a1d2e3f4a5b"
+
+// tweetEngagementService knows a single tweet posted by alice
+type tweetEngagementService struct {
+	fakeService
+}
+
+func (s *tweetEngagementService) ListTweetEngagement(ctx context.Context, params service.ListTweetEngagementParams) ([]models.TweetEngagementDay, error) {
+	if params.TweetID != testTweetID {
+		return nil, pgx.ErrNoRows
+	}
+
+	if params.RequesterID != alice {
+		return nil, service.ErrNotAuthor
+	}
+
+	return []models.TweetEngagementDay{{Likes: 2, Replies: 1}}, nil
+}
+
+func TestListTweetEngagementRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *user.ListTweetEngagementRequest
+		wantCode twirp.ErrorCode
+	}{
+		"the author": {req: &user.ListTweetEngagementRequest{TweetId: testTweetID, RequesterId: alice}},
+		"someone else": {
+			req:      &user.ListTweetEngagementRequest{TweetId: testTweetID, RequesterId: bob},
+			wantCode: twirp.PermissionDenied,
+		},
+		"unknown tweet": {
+			req:      &user.ListTweetEngagementRequest{TweetId: bob, RequesterId: alice},
+			wantCode: twirp.NotFound,
+		},
+		"no tweet":        {req: &user.ListTweetEngagementRequest{RequesterId: alice}, wantCode: twirp.InvalidArgument},
+		"malformed tweet": {req: &user.ListTweetEngagementRequest{TweetId: "1", RequesterId: alice}, wantCode: twirp.InvalidArgument},
+		"no requester":    {req: &user.ListTweetEngagementRequest{TweetId: testTweetID}, wantCode: twirp.InvalidArgument},
+		"malformed requester": {
+			req:      &user.ListTweetEngagementRequest{TweetId: testTweetID, RequesterId: "me"},
+			wantCode: twirp.InvalidArgument,
+		},
+		"malformed to": {
+			req:      &user.ListTweetEngagementRequest{TweetId: testTweetID, RequesterId: alice, To: "yesterday"},
+			wantCode: twirp.InvalidArgument,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := &handler{service: &tweetEngagementService{}}
+
+			res, err := h.ListTweetEngagement(context.Background(), tt.req)
+
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(res.GetDays()) != 1 || res.GetDays()[0].GetLikes() != 2 {
+					t.Errorf("days = %v, want one day with 2 likes", res.GetDays())
+				}
+				return
+			}
+
+			var twerr twirp.Error
+			if !errors.As(err, &twerr) || twerr.Code() != tt.wantCode {
+				t.Errorf("ListTweetEngagement() error = %v, want %s", err, tt.wantCode)
+			}
+		})
+	}
+}
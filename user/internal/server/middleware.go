@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The gateway proves itself with gatewayTokenHeader and names the user it
+// signed in with userIDHeader
+const (
+	gatewayTokenHeader = "X-Gateway-Token"
+	userIDHeader       = "X-User-Id"
+)
+
+type authenticatedUserKey struct{}
+
+// requestTimeout attaches a deadline to every request context so downstream
+// calls (e.g. database queries) are abandoned once the budget is spent
+func requestTimeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if timeout <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// authenticatedUser trusts the user named by a request carrying the gateway
+// token and stores their id in the request context. Requests without the
+// token or with a malformed id stay anonymous, everyone is anonymous when
+// token is empty
+func authenticatedUser(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gatewayToken := r.Header.Get(gatewayTokenHeader)
+
+			if token != "" && subtle.ConstantTimeCompare([]byte(gatewayToken), []byte(token)) == 1 {
+				if id, err := uuid.Parse(r.Header.Get(userIDHeader)); err == nil {
+					r = r.WithContext(context.WithValue(r.Context(), authenticatedUserKey{}, id.String()))
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// authenticatedUserID returns the id of the user the gateway signed in,
+// empty for anonymous requests
+func authenticatedUserID(ctx context.Context) string {
+	id, _ := ctx.Value(authenticatedUserKey{}).(string)
+	return id
+}
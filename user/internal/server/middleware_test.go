@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+// sleepingService takes longer than any request budget used in the tests,
+// returned is closed once FindUserByID gives up
+type sleepingService struct {
+	fakeService
+	returned chan struct{}
+}
+
+func (s *sleepingService) FindUserByID(ctx context.Context, id string) (models.User, error) {
+	defer close(s.returned)
+
+	select {
+	case <-time.After(5 * time.Second):
+		return models.User{ID: id}, nil
+	case <-ctx.Done():
+		return models.User{}, ctx.Err()
+	}
+}
+
+func TestRequestTimeoutIsEnforced(t *testing.T) {
+	svc := &sleepingService{returned: make(chan struct{})}
+	ts := newTestServer(t, testConfig(50*time.Millisecond), svc)
+
+	start := time.Now()
+	status, body := callTwirp(t, ts, "FindUserByID", `{"user_id":"slow"}`)
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("request took %s, the 50ms budget wasn't enforced", elapsed)
+	}
+
+	// Twirp maps deadline_exceeded to 408, not 504
+	if status != http.StatusRequestTimeout {
+		t.Errorf("status = %d, want 408: %s", status, body)
+	}
+
+	// The handler goroutine has to stop too, not just the response
+	select {
+	case <-svc.returned:
+	case <-time.After(time.Second):
+		t.Error("the handler kept running after the deadline")
+	}
+}
+
+func TestRequestTimeoutSetsDeadline(t *testing.T) {
+	var deadline time.Time
+	var ok bool
+
+	handler := requestTimeout(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, ok = r.Context().Deadline()
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if !ok || time.Until(deadline) > time.Minute {
+		t.Errorf("deadline = %s (set %t), want within a minute", deadline, ok)
+	}
+}
+
+func TestRequestTimeoutDisabled(t *testing.T) {
+	var ok bool
+
+	handler := requestTimeout(0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok = r.Context().Deadline()
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if ok {
+		t.Error("a deadline was set with a timeout of 0")
+	}
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/namecheck"
+	"github.com/go-chi/chi/v5"
+)
+
+// TestRoutesAreReservedHandles makes sure no user can pick a handle
+// shadowing one of the routes served here
+func TestRoutesAreReservedHandles(t *testing.T) {
+	names, err := namecheck.New("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := New(testConfig(0), fakeService{}, names)
+
+	routes, ok := srv.Handler.(chi.Routes)
+	if !ok {
+		t.Fatalf("handler is a %T, not a chi router", srv.Handler)
+	}
+
+	var walked int
+
+	err = chi.Walk(routes, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		walked++
+
+		segment, _, _ := strings.Cut(strings.TrimPrefix(route, "/"), "/")
+		if !names.IsReserved(segment) {
+			t.Errorf("%s %s: %q is not a reserved handle", method, route, segment)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if walked == 0 {
+		t.Fatal("no routes were walked")
+	}
+}
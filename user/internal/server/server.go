@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/HotPotatoC/twitter-clone/user/config"
+	"github.com/HotPotatoC/twitter-clone/user/internal/identicon"
+	"github.com/HotPotatoC/twitter-clone/user/internal/namecheck"
 	"github.com/HotPotatoC/twitter-clone/user/internal/service"
 	"github.com/HotPotatoC/twitter-clone/user/rpc/user"
 	"github.com/go-chi/chi/v5"
@@ -11,16 +13,21 @@ import (
 )
 
 // New creates a new user twirp server
-func New(cfg *config.Config, service service.Service) http.Server {
-	handler := newHandler(service)
+func New(cfg *config.Config, service service.Service, names *namecheck.Checker) http.Server {
+	handler := newHandler(service, names)
 	userServiceServer := user.NewUserServiceServer(handler)
 
 	mux := chi.NewMux()
 
 	mux.Use(middleware.RequestID)
 	mux.Use(middleware.RealIP)
+	mux.Use(authenticatedUser(cfg.App.GatewayToken))
 
-	mux.Mount(userServiceServer.PathPrefix(), userServiceServer)
+	// Each route group gets its own time budget
+	mux.With(requestTimeout(cfg.App.RequestTimeout)).
+		Mount(userServiceServer.PathPrefix(), userServiceServer)
+	mux.With(requestTimeout(cfg.App.AvatarRequestTimeout)).
+		Get(defaultAvatarRoute, defaultAvatarHandler(identicon.NewCache()))
 
 	return http.Server{
 		Addr:    cfg.App.Address,
@@ -34,8 +41,9 @@ type Handler interface {
 
 type handler struct {
 	service service.Service
+	names   *namecheck.Checker
 }
 
-func newHandler(service service.Service) Handler {
-	return &handler{service: service}
+func newHandler(service service.Service, names *namecheck.Checker) Handler {
+	return &handler{service: service, names: names}
 }
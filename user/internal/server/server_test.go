@@ -0,0 +1,61 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/config"
+	"github.com/HotPotatoC/twitter-clone/user/internal/namecheck"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service"
+)
+
+// fakeService stubs the service methods a test needs, calling any other
+// method panics on the nil embedded interface
+type fakeService struct {
+	service.Service
+}
+
+// newTestServer serves svc the way New does
+func newTestServer(t *testing.T, cfg *config.Config, svc service.Service) *httptest.Server {
+	t.Helper()
+
+	names, err := namecheck.New("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := New(cfg, svc, names)
+
+	ts := httptest.NewServer(srv.Handler)
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+// callTwirp posts a JSON twirp request and returns the response status and body
+func callTwirp(t *testing.T, ts *httptest.Server, method, body string) (int, string) {
+	t.Helper()
+
+	res, err := http.Post(ts.URL+"/twirp/hotpotatoc.twitter_clone.user.UserService/"+method, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res.StatusCode, string(data)
+}
+
+func testConfig(requestTimeout time.Duration) *config.Config {
+	return &config.Config{
+		App: config.AppConfig{RequestTimeout: requestTimeout, AvatarRequestTimeout: requestTimeout},
+	}
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+)
+
+// fieldErrors collects validation errors per request field so all of them can
+// be reported at once instead of only the first one
+type fieldErrors map[string]string
+
+// add records msg for field, keeping the first error of each field
+func (e fieldErrors) add(field, msg string) {
+	if _, ok := e[field]; ok {
+		return
+	}
+
+	e[field] = msg
+}
+
+// err returns an invalid_argument twirp error with every field error in its
+// meta, e.g. {"email":"invalid","password":"required"}, or nil when valid.
+// Twirp has no code for 422 Unprocessable Entity, so field errors come back
+// as 400 Bad Request with the per-field codes under "meta" rather than an
+// "errors" object
+func (e fieldErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	twerr := twirp.NewError(twirp.InvalidArgument, "invalid fields: "+strings.Join(fields, ", "))
+
+	for _, field := range fields {
+		twerr = twerr.WithMeta(field, e[field])
+	}
+
+	return twerr
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/twitchtv/twirp"
+)
+
+func TestFieldErrors(t *testing.T) {
+	errs := fieldErrors{}
+	errs.add("password", "required")
+	errs.add("email", "invalid")
+	errs.add("email", "required")
+
+	var twerr twirp.Error
+	if !errors.As(errs.err(), &twerr) {
+		t.Fatalf("err() = %v, want a twirp error", errs.err())
+	}
+
+	if twerr.Code() != twirp.InvalidArgument {
+		t.Errorf("code = %s, want %s", twerr.Code(), twirp.InvalidArgument)
+	}
+
+	if want := "invalid fields: email, password"; twerr.Msg() != want {
+		t.Errorf("msg = %q, want %q", twerr.Msg(), want)
+	}
+
+	meta := twerr.MetaMap()
+	want := map[string]string{
+		// The first error of a field is kept
+		"email":    "invalid",
+		"password": "required",
+	}
+
+	if len(meta) != len(want) {
+		t.Errorf("meta = %v, want %v", meta, want)
+	}
+
+	for field, msg := range want {
+		if meta[field] != msg {
+			t.Errorf("meta[%s] = %q, want %q", field, meta[field], msg)
+		}
+	}
+}
+
+func TestFieldErrorsEmpty(t *testing.T) {
+	if err := (fieldErrors{}).err(); err != nil {
+		t.Errorf("err() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+const (
+	defaultAnalyticsDays = 30
+	maxAnalyticsDays     = 366
+)
+
+// ErrInvalidPeriod is returned when an analytics period ends before it
+// starts or spans more than maxAnalyticsDays
+var ErrInvalidPeriod = errors.New("invalid period")
+
+// AnalyticsPeriod selects the days analytics are computed over. From and To
+// are dates at midnight UTC interpreted in the user's time zone, To defaults
+// to today and From to 29 days before To
+type AnalyticsPeriod struct {
+	From time.Time
+	To   time.Time
+}
+
+// resolve returns the first and last day of the period at midnight in the
+// time zone of now
+func (p AnalyticsPeriod) resolve(now time.Time) (time.Time, time.Time, error) {
+	loc := now.Location()
+
+	last := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if !p.To.IsZero() {
+		last = time.Date(p.To.Year(), p.To.Month(), p.To.Day(), 0, 0, 0, 0, loc)
+	}
+
+	first := last.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	if !p.From.IsZero() {
+		first = time.Date(p.From.Year(), p.From.Month(), p.From.Day(), 0, 0, 0, 0, loc)
+	}
+
+	if last.Before(first) || first.AddDate(0, 0, maxAnalyticsDays).Before(last.AddDate(0, 0, 1)) {
+		return time.Time{}, time.Time{}, ErrInvalidPeriod
+	}
+
+	return first, last, nil
+}
+
+// userLocation returns the time zone of the user, UTC if it can't be loaded
+func userLocation(user models.User) *time.Location {
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+const (
+	defaultAutocompleteLimit = 8
+	maxAutocompleteLimit     = 20
+
+	// minAutocompleteQueryLength is the shortest query that also searches
+	// followers and everyone else, shorter ones only match followed users
+	minAutocompleteQueryLength = 2
+)
+
+type AutocompleteUsersParams struct {
+	ViewerID string
+	Query    string
+	Limit    int
+}
+
+func (s *service) AutocompleteUsers(ctx context.Context, params AutocompleteUsersParams) ([]models.User, error) {
+	limit := pagination.ClampLimit(params.Limit, defaultAutocompleteLimit, maxAutocompleteLimit)
+
+	prefix := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(params.Query), "@"))
+	if prefix == "" {
+		return []models.User{}, nil
+	}
+
+	followingOnly := len([]rune(prefix)) < minAutocompleteQueryLength
+
+	// Cache the maximum number of suggestions so every limit can share them
+	key := params.ViewerID + "\x00" + prefix
+
+	connections, ok := s.autocomplete.Get(key)
+	if !ok {
+		var err error
+
+		connections, err = s.repository.AutocompleteConnections(ctx, repository.AutocompleteUsersParams{
+			ViewerID: params.ViewerID,
+			Prefix:   prefix,
+			Limit:    maxAutocompleteLimit,
+		}, followingOnly)
+		if err != nil {
+			return nil, err
+		}
+
+		s.autocomplete.Set(key, connections)
+	}
+
+	users := make([]models.User, 0, limit)
+	for _, user := range connections {
+		if len(users) == limit {
+			break
+		}
+
+		users = append(users, s.withDefaultProfileImage(user))
+	}
+
+	if followingOnly || len(users) == limit {
+		return users, nil
+	}
+
+	excludeIDs := make([]string, 0, len(users)+1)
+	excludeIDs = append(excludeIDs, params.ViewerID)
+	for _, user := range users {
+		excludeIDs = append(excludeIDs, user.ID)
+	}
+
+	others, err := s.repository.AutocompleteUsers(ctx, repository.AutocompleteUsersParams{
+		Prefix:     prefix,
+		Limit:      limit - len(users),
+		ExcludeIDs: excludeIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range others {
+		users = append(users, s.withDefaultProfileImage(user))
+	}
+
+	return users, nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+func TestAutocompleteUsersTiers(t *testing.T) {
+	var excluded []string
+
+	repo := &fakeRepository{
+		autocompleteConnections: func(params repository.AutocompleteUsersParams, followingOnly bool) ([]models.User, error) {
+			if followingOnly {
+				t.Error("followers were left out of a 2 characters query")
+			}
+
+			if params.Prefix != "jo" {
+				t.Errorf("prefix = %q, want jo", params.Prefix)
+			}
+
+			// The repository returns followed users before followers
+			return []models.User{{ID: "followed"}, {ID: "follower"}}, nil
+		},
+		autocompleteUsers: func(params repository.AutocompleteUsersParams) ([]models.User, error) {
+			excluded = params.ExcludeIDs
+
+			if params.Limit != 2 {
+				t.Errorf("limit = %d, want the 2 remaining slots", params.Limit)
+			}
+
+			return []models.User{{ID: "popular"}, {ID: "less-popular"}}, nil
+		},
+	}
+
+	users, err := newTestService(t, repo).AutocompleteUsers(context.Background(), AutocompleteUsersParams{
+		ViewerID: "viewer",
+		Query:    "@Jo",
+		Limit:    4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := userIDs(users), []string{"followed", "follower", "popular", "less-popular"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("users = %v, want %v", got, want)
+	}
+
+	if want := []string{"viewer", "followed", "follower"}; !reflect.DeepEqual(excluded, want) {
+		t.Errorf("excluded = %v, want %v", excluded, want)
+	}
+}
+
+func TestAutocompleteUsersShortQueryOnlyMatchesFollowing(t *testing.T) {
+	repo := &fakeRepository{
+		autocompleteConnections: func(params repository.AutocompleteUsersParams, followingOnly bool) ([]models.User, error) {
+			if !followingOnly {
+				t.Error("a 1 character query matched more than followed users")
+			}
+
+			return []models.User{{ID: "followed"}}, nil
+		},
+		autocompleteUsers: func(params repository.AutocompleteUsersParams) ([]models.User, error) {
+			t.Error("a 1 character query searched everyone")
+			return nil, nil
+		},
+	}
+
+	users, err := newTestService(t, repo).AutocompleteUsers(context.Background(), AutocompleteUsersParams{
+		ViewerID: "viewer",
+		Query:    "@j",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := userIDs(users), []string{"followed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("users = %v, want %v", got, want)
+	}
+}
+
+func TestAutocompleteUsersCachesConnections(t *testing.T) {
+	calls := 0
+
+	repo := &fakeRepository{
+		autocompleteConnections: func(params repository.AutocompleteUsersParams, followingOnly bool) ([]models.User, error) {
+			calls++
+			return []models.User{{ID: "followed"}}, nil
+		},
+		autocompleteUsers: func(params repository.AutocompleteUsersParams) ([]models.User, error) {
+			return nil, nil
+		},
+	}
+
+	s := newTestService(t, repo)
+
+	for i := 0; i < 3; i++ {
+		if _, err := s.AutocompleteUsers(context.Background(), AutocompleteUsersParams{ViewerID: "viewer", Query: "jo"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("connections queried %d times, want 1", calls)
+	}
+}
+
+func TestAutocompleteUsersEmptyQuery(t *testing.T) {
+	users, err := newTestService(t, &fakeRepository{}).AutocompleteUsers(context.Background(), AutocompleteUsersParams{
+		ViewerID: "viewer",
+		Query:    " @ ",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 0 {
+		t.Errorf("users = %v, want none", users)
+	}
+}
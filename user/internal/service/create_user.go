@@ -2,12 +2,23 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/HotPotatoC/twitter-clone/user/internal/models"
 	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 )
 
+// ErrEmailTaken is returned when signing up with the email of an existing
+// user
+var ErrEmailTaken = errors.New("email already exists")
+
+// uniqueViolation is the SQLSTATE reported when an insert breaks a unique
+// index
+const uniqueViolation = "23505"
+
 type CreateUserParams struct {
 	Name             string    `json:"name"`
 	ScreenName       string    `json:"screen_name"`
@@ -19,9 +30,26 @@ type CreateUserParams struct {
 	ProfileImageURL  string    `json:"profile_image_url"`
 	ProfileBannerURL string    `json:"profile_banner_url"`
 	BirthDate        time.Time `json:"birth_date"`
+	InviteToken      string    `json:"invite_token"`
+	Timezone         string    `json:"timezone"`
 }
 
 func (s *service) CreateUser(ctx context.Context, params CreateUserParams) (models.User, error) {
+	inviteCode, err := s.checkRegistration(params.InviteToken)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	// Checked on the writer without the not found cache, a miss cached
+	// before the email signed up elsewhere would let it sign up twice
+	_, err = s.repository.FindUserByEmailFromWriter(ctx, params.Email)
+	if err == nil {
+		return models.User{}, ErrEmailTaken
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return models.User{}, err
+	}
+
 	password := models.Password(params.RawPassword)
 
 	if err := password.Validate(); err != nil {
@@ -33,7 +61,16 @@ func (s *service) CreateUser(ctx context.Context, params CreateUserParams) (mode
 		return models.User{}, err
 	}
 
-	user, err := s.repository.CreateUser(ctx, models.User{
+	timezone := params.Timezone
+	if timezone == "" {
+		timezone = models.DefaultTimezone
+	}
+
+	// Stored as UTC wall-clock time in columns without a time zone, the
+	// invite code redeemed along with it is compared against it
+	now := time.Now().UTC()
+
+	newUser := models.User{
 		ID:               uuid.New().String(),
 		Name:             params.Name,
 		ScreenName:       params.ScreenName,
@@ -47,12 +84,40 @@ func (s *service) CreateUser(ctx context.Context, params CreateUserParams) (mode
 		BirthDate:        params.BirthDate,
 		FollowersCount:   0,
 		FollowingsCount:  0,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
-	})
+		TweetsCount:      0,
+		Timezone:         timezone,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	var user models.User
+
+	if inviteCode != "" {
+		user, err = s.repository.CreateUserWithInvite(ctx, newUser, inviteCode)
+		if errors.Is(err, pgx.ErrNoRows) {
+			if !s.registration.Open {
+				return models.User{}, ErrInvalidInvite
+			}
+
+			// An unknown or used up code doesn't stop an open signup, it
+			// just doesn't credit anyone
+			user, err = s.repository.CreateUser(ctx, newUser)
+		}
+	} else {
+		user, err = s.repository.CreateUser(ctx, newUser)
+	}
 	if err != nil {
+		// Two signups with the same email racing past the check above
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "users_email_idx" {
+			return models.User{}, ErrEmailTaken
+		}
+
 		return models.User{}, err
 	}
 
-	return user, nil
+	s.notFound.Delete("email:" + user.Email)
+	s.notFound.Delete("id:" + user.ID)
+
+	return s.withDefaultProfileImage(user), nil
 }
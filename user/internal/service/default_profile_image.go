@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+// withDefaultProfileImage points users without a profile image to their
+// generated avatar so clients can treat the field as always present
+func (s *service) withDefaultProfileImage(user models.User) models.User {
+	if user.ProfileImageURL == "" && user.ID != "" {
+		user.ProfileImageURL = s.publicURL + models.DefaultProfileImagePath(user.ID)
+	}
+
+	return user
+}
@@ -2,8 +2,18 @@ package service
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 func (s *service) DeleteUser(ctx context.Context, id string) error {
-	return s.repository.DeleteUser(ctx, id)
+	if err := s.repository.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+
+	if parsed, err := uuid.Parse(id); err == nil {
+		s.users.Delete(parsed.String())
+	}
+
+	return nil
 }
@@ -2,10 +2,28 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/jackc/pgx/v4"
 )
 
 func (s *service) FindUserByEmail(ctx context.Context, email string) (models.User, error) {
-	return s.repository.FindUserByEmail(ctx, email)
+	key := "email:" + email
+
+	if _, ok := s.notFound.Get(key); ok {
+		return models.User{}, pgx.ErrNoRows
+	}
+
+	user, err := s.repository.FindUserByEmail(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The replica may lag behind a signup, only misses confirmed by the
+		// writer get cached
+		user, err = s.repository.FindUserByEmailFromWriter(ctx, email)
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.notFound.Set(key, struct{}{})
+		}
+	}
+
+	return s.withDefaultProfileImage(user), err
 }
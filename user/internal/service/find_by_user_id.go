@@ -2,10 +2,37 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 )
 
 func (s *service) FindUserByID(ctx context.Context, id string) (models.User, error) {
-	return s.repository.FindUserByID(ctx, id)
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		// Can't match any user
+		return models.User{}, pgx.ErrNoRows
+	}
+
+	// Keyed by the canonical uuid like FindUsersByIDs, so both share misses
+	id = parsed.String()
+	key := "id:" + id
+
+	if _, ok := s.notFound.Get(key); ok {
+		return models.User{}, pgx.ErrNoRows
+	}
+
+	user, err := s.repository.FindUserByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The replica may lag behind a signup, only misses confirmed by the
+		// writer get cached
+		user, err = s.repository.FindUserByIDFromWriter(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.notFound.Set(key, struct{}{})
+		}
+	}
+
+	return s.withDefaultProfileImage(user), err
 }
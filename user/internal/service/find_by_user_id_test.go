@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestFindUserByIDCachesMisses(t *testing.T) {
+	readerCalls, writerCalls := 0, 0
+
+	repo := &fakeRepository{
+		findUserByID: func(id string) (models.User, error) {
+			readerCalls++
+			return models.User{}, pgx.ErrNoRows
+		},
+		findUserByIDFromWriter: func(id string) (models.User, error) {
+			writerCalls++
+			return models.User{}, pgx.ErrNoRows
+		},
+	}
+
+	s := newTestService(t, repo)
+
+	for i := 0; i < 2; i++ {
+		if _, err := s.FindUserByID(context.Background(), testUserID); !errors.Is(err, pgx.ErrNoRows) {
+			t.Fatalf("lookup %d error = %v, want pgx.ErrNoRows", i+1, err)
+		}
+	}
+
+	if readerCalls != 1 || writerCalls != 1 {
+		t.Errorf("reader queried %d times and writer %d times, want once each", readerCalls, writerCalls)
+	}
+}
+
+func TestFindUserByIDReplicaLag(t *testing.T) {
+	repo := &fakeRepository{
+		findUserByID: func(id string) (models.User, error) {
+			return models.User{}, pgx.ErrNoRows
+		},
+		findUserByIDFromWriter: func(id string) (models.User, error) {
+			return models.User{ID: id}, nil
+		},
+	}
+
+	s := newTestService(t, repo)
+
+	user, err := s.FindUserByID(context.Background(), testUserID)
+	if err != nil || user.ID != testUserID {
+		t.Fatalf("FindUserByID() = %+v, %v, want the user from the writer", user, err)
+	}
+
+	if _, ok := s.notFound.Get("id:" + testUserID); ok {
+		t.Error("a user missing only from the replica was cached as not found")
+	}
+}
+
+func TestNotFoundSharedByIDLookups(t *testing.T) {
+	calls := 0
+
+	repo := &fakeRepository{
+		findUserByID: func(id string) (models.User, error) {
+			calls++
+			return models.User{}, pgx.ErrNoRows
+		},
+		findUserByIDFromWriter: func(id string) (models.User, error) {
+			return models.User{}, pgx.ErrNoRows
+		},
+		findUsersByIDs: func(ids []string) ([]models.User, error) {
+			calls++
+			return nil, nil
+		},
+		findUsersByIDsFromWriter: func(ids []string) ([]models.User, error) {
+			return nil, nil
+		},
+	}
+
+	upper := strings.ToUpper(testUserID)
+
+	t.Run("single then batch", func(t *testing.T) {
+		calls = 0
+		s := newTestService(t, repo)
+
+		if _, err := s.FindUserByID(context.Background(), upper); !errors.Is(err, pgx.ErrNoRows) {
+			t.Fatalf("FindUserByID() error = %v, want pgx.ErrNoRows", err)
+		}
+
+		if _, err := s.FindUsersByIDs(context.Background(), []string{testUserID}); err != nil {
+			t.Fatal(err)
+		}
+
+		if calls != 1 {
+			t.Errorf("queried %d times, want the batch lookup served by the cached miss", calls)
+		}
+	})
+
+	t.Run("batch then single", func(t *testing.T) {
+		calls = 0
+		s := newTestService(t, repo)
+
+		if _, err := s.FindUsersByIDs(context.Background(), []string{upper}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.FindUserByID(context.Background(), testUserID); !errors.Is(err, pgx.ErrNoRows) {
+			t.Fatalf("FindUserByID() error = %v, want pgx.ErrNoRows", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("queried %d times, want the single lookup served by the cached miss", calls)
+		}
+	})
+}
+
+func TestFindUserByIDMalformedID(t *testing.T) {
+	s := newTestService(t, &fakeRepository{})
+
+	if _, err := s.FindUserByID(context.Background(), "not-a-uuid"); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("FindUserByID() error = %v, want pgx.ErrNoRows without querying", err)
+	}
+}
+
+func TestCreateUserClearsNotFound(t *testing.T) {
+	repo := newSignupRepository()
+	s := newTestService(t, repo)
+
+	s.notFound.Set("email:john@example.com", struct{}{})
+
+	user, err := s.CreateUser(context.Background(), signupParams(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := s.notFound.Get("email:" + user.Email); ok {
+		t.Error("the email of a new user is still cached as not found")
+	}
+}
+
+func TestCreateUserEmailTaken(t *testing.T) {
+	repo := newSignupRepository()
+	repo.findUserByEmailFromWriter = func(email string) (models.User, error) {
+		return models.User{ID: "existing-user", Email: email}, nil
+	}
+	s := newTestService(t, repo)
+
+	// A miss cached before the email signed up on another instance
+	s.notFound.Set("email:john@example.com", struct{}{})
+
+	_, err := s.CreateUser(context.Background(), signupParams(""))
+	if !errors.Is(err, ErrEmailTaken) {
+		t.Errorf("CreateUser() error = %v, want ErrEmailTaken", err)
+	}
+
+	if repo.created {
+		t.Error("a second user was created with the same email")
+	}
+}
+
+func TestCreateUserEmailUniqueViolation(t *testing.T) {
+	repo := newSignupRepository()
+	repo.createUser = func(user models.User) (models.User, error) {
+		return models.User{}, &pgconn.PgError{Code: uniqueViolation, ConstraintName: "users_email_idx"}
+	}
+	s := newTestService(t, repo)
+
+	_, err := s.CreateUser(context.Background(), signupParams(""))
+	if !errors.Is(err, ErrEmailTaken) {
+		t.Errorf("CreateUser() error = %v, want ErrEmailTaken", err)
+	}
+}
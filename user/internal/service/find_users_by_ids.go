@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/google/uuid"
+)
+
+// FindUsersByIDs serves users from the per-user cache and looks up every
+// other id with a single query. The result is keyed by the ids as given, so
+// callers find their entries whatever the spelling of the uuid
+func (s *service) FindUsersByIDs(ctx context.Context, ids []string) (map[string]models.User, error) {
+	users := make(map[string]models.User, len(ids))
+
+	// Requested ids by their canonical form
+	requested := make(map[string][]string, len(ids))
+	found := make(map[string]models.User, len(ids))
+
+	var lookup []string
+
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			// Can't match any user
+			continue
+		}
+
+		canonical := parsed.String()
+
+		if _, ok := requested[canonical]; !ok {
+			if user, ok := s.users.Get(canonical); ok {
+				found[canonical] = user
+			} else if _, ok := s.notFound.Get("id:" + canonical); !ok {
+				lookup = append(lookup, canonical)
+			}
+		}
+
+		requested[canonical] = append(requested[canonical], id)
+	}
+
+	if len(lookup) > 0 {
+		if err := s.findUncachedUsers(ctx, lookup, found); err != nil {
+			return nil, err
+		}
+	}
+
+	for canonical, user := range found {
+		user = s.withDefaultProfileImage(user)
+
+		for _, id := range requested[canonical] {
+			users[id] = user
+		}
+	}
+
+	return users, nil
+}
+
+// findUncachedUsers looks up canonical ids missing from the caches into
+// found, retrying replica misses on the writer before caching them as not
+// found
+func (s *service) findUncachedUsers(ctx context.Context, ids []string, found map[string]models.User) error {
+	users, err := s.repository.FindUsersByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	s.cacheUsers(users, found)
+
+	var missing []string
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	users, err = s.repository.FindUsersByIDsFromWriter(ctx, missing)
+	if err != nil {
+		return err
+	}
+
+	s.cacheUsers(users, found)
+
+	for _, id := range missing {
+		if _, ok := found[id]; !ok {
+			s.notFound.Set("id:"+id, struct{}{})
+		}
+	}
+
+	return nil
+}
+
+func (s *service) cacheUsers(users []models.User, found map[string]models.User) {
+	for _, user := range users {
+		s.users.Set(user.ID, user)
+		found[user.ID] = user
+	}
+}
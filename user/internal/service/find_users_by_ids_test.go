@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+const (
+	aliceID   = "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+	bobID     = "1e4b9c2d-8f13-4b7a-a4c6-58d2e3f1b602"
+	carolID   = "2f5cad3e-9024-4c8b-b5d7-69e3f402c703"
+	missingID = "3a6dbe4f-a135-4d9c-86e8-7af405134d04"
+)
+
+// usersRepository serves a fixed set of users and records every batch
+type usersRepository struct {
+	fakeRepository
+
+	readerBatches [][]string
+	writerBatches [][]string
+}
+
+func newUsersRepository(users ...models.User) *usersRepository {
+	r := &usersRepository{}
+
+	find := func(ids []string) []models.User {
+		var found []models.User
+		for _, id := range ids {
+			for _, user := range users {
+				if user.ID == id {
+					found = append(found, user)
+				}
+			}
+		}
+
+		return found
+	}
+
+	r.findUsersByIDs = func(ids []string) ([]models.User, error) {
+		r.readerBatches = append(r.readerBatches, sorted(ids))
+		return find(ids), nil
+	}
+
+	r.findUsersByIDsFromWriter = func(ids []string) ([]models.User, error) {
+		r.writerBatches = append(r.writerBatches, sorted(ids))
+		return find(ids), nil
+	}
+
+	return r
+}
+
+func sorted(ids []string) []string {
+	ids = append([]string{}, ids...)
+	sort.Strings(ids)
+	return ids
+}
+
+func TestFindUsersByIDsMixesCachedAndUncached(t *testing.T) {
+	repo := newUsersRepository(
+		models.User{ID: aliceID, ScreenName: "alice"},
+		models.User{ID: bobID, ScreenName: "bob"},
+		models.User{ID: carolID, ScreenName: "carol"},
+	)
+	s := newTestService(t, repo)
+	ctx := context.Background()
+
+	if _, err := s.FindUsersByIDs(ctx, []string{aliceID, missingID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// alice is cached, missing is known to be missing, only bob and carol
+	// are left for a single query
+	users, err := s.FindUsersByIDs(ctx, []string{aliceID, bobID, carolID, missingID, bobID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantBatches := [][]string{sorted([]string{aliceID, missingID}), sorted([]string{bobID, carolID})}
+	if !reflect.DeepEqual(repo.readerBatches, wantBatches) {
+		t.Errorf("reader batches = %v, want %v", repo.readerBatches, wantBatches)
+	}
+
+	if want := [][]string{{missingID}}; !reflect.DeepEqual(repo.writerBatches, want) {
+		t.Errorf("writer batches = %v, want only the first miss confirmed", repo.writerBatches)
+	}
+
+	if len(users) != 3 || users[aliceID].ScreenName != "alice" || users[bobID].ScreenName != "bob" || users[carolID].ScreenName != "carol" {
+		t.Errorf("users = %v, want alice, bob and carol", users)
+	}
+
+	if _, err := s.FindUsersByIDs(ctx, []string{aliceID, bobID, carolID}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(repo.readerBatches) != 2 {
+		t.Errorf("fully cached lookup queried the database, batches = %v", repo.readerBatches)
+	}
+}
+
+func TestFindUsersByIDsKeyedAsRequested(t *testing.T) {
+	repo := newUsersRepository(models.User{ID: aliceID, ScreenName: "alice"})
+	s := newTestService(t, repo)
+
+	upper := strings.ToUpper(aliceID)
+
+	users, err := s.FindUsersByIDs(context.Background(), []string{upper, aliceID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if users[upper].ScreenName != "alice" || users[aliceID].ScreenName != "alice" {
+		t.Errorf("users = %v, want alice under both spellings", users)
+	}
+
+	if _, ok := s.notFound.Get("id:" + upper); ok {
+		t.Error("an uppercase id was cached as not found")
+	}
+
+	if len(repo.readerBatches) != 1 || len(repo.readerBatches[0]) != 1 {
+		t.Errorf("reader batches = %v, want a single canonical id", repo.readerBatches)
+	}
+}
+
+func TestFindUsersByIDsReplicaLag(t *testing.T) {
+	repo := newUsersRepository(models.User{ID: aliceID, ScreenName: "alice"})
+	repo.findUsersByIDs = func(ids []string) ([]models.User, error) {
+		return nil, nil
+	}
+
+	s := newTestService(t, repo)
+
+	users, err := s.FindUsersByIDs(context.Background(), []string{aliceID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if users[aliceID].ScreenName != "alice" {
+		t.Errorf("users = %v, want alice from the writer", users)
+	}
+
+	if _, ok := s.notFound.Get("id:" + aliceID); ok {
+		t.Error("a user missing only from the replica was cached as not found")
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+var (
+	// ErrInviteLimitReached is returned when a user generated too many invite
+	// codes in the last 24 hours
+	ErrInviteLimitReached = errors.New("invite limit reached")
+
+	// ErrInviteNotAllowed is returned when a user who isn't an admin
+	// generates an invite while only admins are allowed to
+	ErrInviteNotAllowed = errors.New("not allowed to invite")
+
+	// ErrInviteMaxUsesTooHigh is returned when a user who isn't an admin asks
+	// for more uses than configured
+	ErrInviteMaxUsesTooHigh = errors.New("invite max uses too high")
+
+	// ErrInviteExpiryTooLate is returned when a user who isn't an admin asks
+	// for an invite living longer than configured
+	ErrInviteExpiryTooLate = errors.New("invite expiry too late")
+)
+
+type GenerateInviteParams struct {
+	InviterID string
+	// ActorID is the authenticated user, empty when anonymous. The limits
+	// are only lifted for an admin generating their own invites
+	ActorID string
+	// MaxUses defaults to 1
+	MaxUses int
+	// ExpiresAt defaults to the configured invite lifetime from now
+	ExpiresAt time.Time
+}
+
+func (s *service) GenerateInvite(ctx context.Context, params GenerateInviteParams) (models.InviteCode, error) {
+	if _, err := s.FindUserByID(ctx, params.InviterID); err != nil {
+		return models.InviteCode{}, err
+	}
+
+	admin := strings.EqualFold(params.ActorID, params.InviterID) && s.isAdmin(params.ActorID)
+	if !admin && !s.registration.AnyUserCanInvite {
+		return models.InviteCode{}, ErrInviteNotAllowed
+	}
+
+	// Invite times are stored as UTC wall-clock time in columns without a
+	// time zone
+	now := time.Now().UTC()
+
+	maxUses := params.MaxUses
+	if maxUses <= 0 {
+		maxUses = 1
+	}
+
+	expiresAt := params.ExpiresAt.UTC()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(s.registration.InviteTTL)
+	}
+
+	if !admin {
+		if maxUses > s.registration.MaxInviteUses {
+			return models.InviteCode{}, ErrInviteMaxUsesTooHigh
+		}
+
+		if expiresAt.After(now.Add(s.registration.InviteTTL)) {
+			return models.InviteCode{}, ErrInviteExpiryTooLate
+		}
+
+		count, err := s.repository.CountInviteCodesSince(ctx, params.InviterID, now.Add(-24*time.Hour))
+		if err != nil {
+			return models.InviteCode{}, err
+		}
+
+		if count >= s.registration.InvitesPerDay {
+			return models.InviteCode{}, ErrInviteLimitReached
+		}
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		return models.InviteCode{}, err
+	}
+
+	return s.repository.CreateInviteCode(ctx, models.InviteCode{
+		Code:      code,
+		InviterID: params.InviterID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+}
+
+// generateInviteCode generates a random 16 characters code
+func generateInviteCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+const (
+	testAdminID = "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"
+	testUserID  = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+)
+
+// inviteRepository knows every user and has invitesToday codes generated in
+// the last 24 hours for each of them
+type inviteRepository struct {
+	fakeRepository
+
+	created []models.InviteCode
+}
+
+func newInviteRepository(invitesToday int) *inviteRepository {
+	r := &inviteRepository{}
+
+	r.findUserByID = func(id string) (models.User, error) {
+		return models.User{ID: id}, nil
+	}
+
+	r.countInviteCodesSince = func(inviterID string, since time.Time) (int, error) {
+		return invitesToday, nil
+	}
+
+	r.createInviteCode = func(invite models.InviteCode) (models.InviteCode, error) {
+		r.created = append(r.created, invite)
+		return invite, nil
+	}
+
+	return r
+}
+
+func TestGenerateInviteLimits(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		inviterID       string
+		actorID         string
+		maxUses         int
+		expiresAt       time.Time
+		invitesToday    int
+		adminsOnly      bool
+		wantErr         error
+		wantMaxUses     int
+		wantExpiryAfter time.Time
+	}{
+		"defaults": {
+			inviterID:   testUserID,
+			wantMaxUses: 1,
+		},
+		"max uses over the limit": {
+			inviterID: testUserID,
+			maxUses:   2,
+			wantErr:   ErrInviteMaxUsesTooHigh,
+		},
+		"expiry over the limit": {
+			inviterID: testUserID,
+			expiresAt: now.Add(48 * time.Hour),
+			wantErr:   ErrInviteExpiryTooLate,
+		},
+		"daily limit reached": {
+			inviterID:    testUserID,
+			invitesToday: 5,
+			wantErr:      ErrInviteLimitReached,
+		},
+		"daily limit almost reached": {
+			inviterID:    testUserID,
+			invitesToday: 4,
+			wantMaxUses:  1,
+		},
+		"admins only": {
+			inviterID:  testUserID,
+			adminsOnly: true,
+			wantErr:    ErrInviteNotAllowed,
+		},
+		"admin over every limit": {
+			inviterID:       testAdminID,
+			actorID:         testAdminID,
+			maxUses:         100,
+			expiresAt:       now.Add(30 * 24 * time.Hour),
+			invitesToday:    50,
+			adminsOnly:      true,
+			wantMaxUses:     100,
+			wantExpiryAfter: now.Add(29 * 24 * time.Hour),
+		},
+		"spoofed admin": {
+			inviterID: testAdminID,
+			maxUses:   100,
+			wantErr:   ErrInviteMaxUsesTooHigh,
+		},
+		"admin inviting for someone else": {
+			inviterID: testUserID,
+			actorID:   testAdminID,
+			maxUses:   100,
+			wantErr:   ErrInviteMaxUsesTooHigh,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := newInviteRepository(tt.invitesToday)
+			s := newTestService(t, repo)
+			s.registration.AdminIDs = []string{testAdminID}
+			s.registration.AnyUserCanInvite = !tt.adminsOnly
+
+			invite, err := s.GenerateInvite(context.Background(), GenerateInviteParams{
+				InviterID: tt.inviterID,
+				ActorID:   tt.actorID,
+				MaxUses:   tt.maxUses,
+				ExpiresAt: tt.expiresAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GenerateInvite() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if len(repo.created) != 0 {
+					t.Error("an invite was created")
+				}
+				return
+			}
+
+			if invite.MaxUses != tt.wantMaxUses {
+				t.Errorf("max uses = %d, want %d", invite.MaxUses, tt.wantMaxUses)
+			}
+
+			if len(invite.Code) != 16 || invite.InviterID != tt.inviterID {
+				t.Errorf("invite = %+v, want a 16 characters code from %s", invite, tt.inviterID)
+			}
+
+			if invite.ExpiresAt.Before(tt.wantExpiryAfter) || invite.ExpiresAt.After(now.Add(31*24*time.Hour)) {
+				t.Errorf("expires at %s, want after %s", invite.ExpiresAt, tt.wantExpiryAfter)
+			}
+		})
+	}
+}
+
+func TestGenerateInviteStoresUTC(t *testing.T) {
+	zone := time.FixedZone("UTC+9", 9*60*60)
+
+	for name, expiresAt := range map[string]time.Time{
+		"default expiry":  {},
+		"caller's expiry": time.Now().In(zone).Add(time.Hour),
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := newInviteRepository(0)
+			s := newTestService(t, repo)
+
+			invite, err := s.GenerateInvite(context.Background(), GenerateInviteParams{
+				InviterID: testUserID,
+				ExpiresAt: expiresAt,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if invite.CreatedAt.Location() != time.UTC || invite.ExpiresAt.Location() != time.UTC {
+				t.Errorf("created at %s and expires at %s, want both in UTC", invite.CreatedAt, invite.ExpiresAt)
+			}
+		})
+	}
+}
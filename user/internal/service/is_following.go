@@ -0,0 +1,9 @@
+package service
+
+import (
+	"context"
+)
+
+func (s *service) IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error) {
+	return s.repository.IsFollowing(ctx, followerID, followeeID)
+}
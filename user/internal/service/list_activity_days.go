@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+const dateLayout = "2006-01-02"
+
+func (s *service) ListActivityDays(ctx context.Context, userID string, weeks int) ([]models.ActivityDay, error) {
+	user, err := s.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := userLocation(user)
+
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	first := today.AddDate(0, 0, -(weeks*7 - 1))
+
+	// The period moves at midnight in the user's timezone, so a cached
+	// series is only served on the day it was built
+	key := user.ID + ":" + strconv.Itoa(weeks) + ":" + today.Format(dateLayout)
+
+	if days, ok := s.activity.Get(key); ok {
+		return days, nil
+	}
+
+	activeDays, err := s.repository.ListActivityDays(ctx, repository.ListActivityDaysParams{
+		UserID:   user.ID,
+		Timezone: loc.String(),
+		Since:    first,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[string]models.ActivityDay, len(activeDays))
+	for _, day := range activeDays {
+		active[day.Date.Format(dateLayout)] = day
+	}
+
+	// Fill the days without activity so clients get a dense series
+	days := make([]models.ActivityDay, 0, weeks*7)
+	for date := first; !date.After(today); date = date.AddDate(0, 0, 1) {
+		day := active[date.Format(dateLayout)]
+		day.Date = date
+		days = append(days, day)
+	}
+
+	s.activity.Set(key, days)
+
+	return days, nil
+}
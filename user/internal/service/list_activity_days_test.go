@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+// activityRepository knows a single user with one tweet today
+type activityRepository struct {
+	fakeRepository
+
+	queries int
+}
+
+func newActivityRepository(user models.User) *activityRepository {
+	r := &activityRepository{}
+
+	r.findUserByID = func(id string) (models.User, error) {
+		return user, nil
+	}
+
+	r.listActivityDays = func(params repository.ListActivityDaysParams) ([]models.ActivityDay, error) {
+		r.queries++
+
+		loc, _ := time.LoadLocation(params.Timezone)
+		now := time.Now().In(loc)
+
+		return []models.ActivityDay{
+			{Date: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), Tweets: 1},
+		}, nil
+	}
+
+	return r
+}
+
+func TestListActivityDays(t *testing.T) {
+	user := models.User{ID: "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01", Timezone: "Asia/Tokyo"}
+	s := newTestService(t, newActivityRepository(user))
+
+	days, err := s.ListActivityDays(context.Background(), user.ID, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(days) != 14 {
+		t.Fatalf("got %d days, want 14", len(days))
+	}
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	today := time.Now().In(tokyo).Format(dateLayout)
+
+	last := days[len(days)-1]
+	if last.Date.Format(dateLayout) != today || last.Tweets != 1 {
+		t.Errorf("last day = %s with %d tweets, want %s with 1", last.Date.Format(dateLayout), last.Tweets, today)
+	}
+
+	for _, day := range days[:len(days)-1] {
+		if day.Tweets != 0 {
+			t.Errorf("%s has %d tweets, want 0", day.Date.Format(dateLayout), day.Tweets)
+		}
+	}
+}
+
+func TestListActivityDaysCache(t *testing.T) {
+	user := models.User{ID: "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01", Timezone: models.DefaultTimezone}
+	repo := newActivityRepository(user)
+	s := newTestService(t, repo)
+
+	for i := 0; i < 2; i++ {
+		if _, err := s.ListActivityDays(context.Background(), user.ID, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if repo.queries != 1 {
+		t.Errorf("activity was queried %d times, want once", repo.queries)
+	}
+}
+
+func TestListActivityDaysCacheRollsOver(t *testing.T) {
+	user := models.User{ID: "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01", Timezone: models.DefaultTimezone}
+	repo := newActivityRepository(user)
+	s := newTestService(t, repo)
+
+	// A series cached yesterday must not be served today
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format(dateLayout)
+	s.activity.Set(user.ID+":1:"+yesterday, []models.ActivityDay{{Tweets: 99}})
+
+	days, err := s.ListActivityDays(context.Background(), user.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repo.queries != 1 || len(days) != 7 {
+		t.Errorf("got %d days after %d queries, want a fresh series of 7", len(days), repo.queries)
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+type ListDailyStatsParams struct {
+	// RequesterID is the authenticated user, empty when anonymous
+	RequesterID string
+	Period      AnalyticsPeriod
+	// Timezone is the IANA name of the zone days are bucketed in, defaults
+	// to UTC
+	Timezone string
+}
+
+func (s *service) ListDailyStats(ctx context.Context, params ListDailyStatsParams) ([]models.DailyStat, error) {
+	if !s.isAdmin(params.RequesterID) {
+		return nil, ErrAdminRequired
+	}
+
+	// The handler validates the zone, an empty one loads as UTC
+	loc, err := time.LoadLocation(params.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	first, last, err := params.Period.resolve(time.Now().In(loc))
+	if err != nil {
+		return nil, err
+	}
+
+	// Today's counts keep growing, so a cached series is only as fresh as
+	// CACHE_STATS_TTL
+	key := loc.String() + ":" + first.Format(dateLayout) + ":" + last.Format(dateLayout)
+
+	if days, ok := s.stats.Get(key); ok {
+		return days, nil
+	}
+
+	statDays, err := s.repository.ListDailyStats(ctx, repository.ListDailyStatsParams{
+		Timezone: loc.String(),
+		Since:    first,
+		Until:    last.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := fillDailyStats(statDays, first, last)
+
+	s.stats.Set(key, days)
+
+	return days, nil
+}
+
+// fillDailyStats returns an entry for every day from first to last, days
+// missing from statDays are left at zero so clients get a dense series
+func fillDailyStats(statDays []models.DailyStat, first, last time.Time) []models.DailyStat {
+	stats := make(map[string]models.DailyStat, len(statDays))
+	for _, day := range statDays {
+		stats[day.Date.Format(dateLayout)] = day
+	}
+
+	var days []models.DailyStat
+
+	// AddDate keeps midnight across DST changes, where a day is 23 or 25
+	// hours long
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		day := stats[date.Format(dateLayout)]
+		day.Date = date
+		days = append(days, day)
+	}
+
+	return days
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+// statsRepository records the period it was asked for and reports a signup
+// and a tweet on the given day
+type statsRepository struct {
+	fakeRepository
+
+	queries int
+	params  repository.ListDailyStatsParams
+}
+
+func newStatsRepository(day time.Time) *statsRepository {
+	r := &statsRepository{}
+
+	r.listDailyStats = func(params repository.ListDailyStatsParams) ([]models.DailyStat, error) {
+		r.queries++
+		r.params = params
+
+		return []models.DailyStat{{Date: day, Signups: 1, Tweets: 2}}, nil
+	}
+
+	return r
+}
+
+func newStatsService(t *testing.T, repo repository.Repository) *service {
+	t.Helper()
+
+	s := newTestService(t, repo)
+	s.registration.AdminIDs = []string{testUserID}
+
+	return s
+}
+
+func TestListDailyStatsDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		from, to  time.Time
+		wantHours float64
+	}{
+		"clocks go forward": {
+			from:      time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+			to:        time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+			wantHours: 71,
+		},
+		"clocks go back": {
+			from:      time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+			to:        time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+			wantHours: 73,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The day the clocks change
+			changeDay := time.Date(tt.from.Year(), tt.from.Month(), tt.from.Day()+1, 0, 0, 0, 0, time.UTC)
+
+			repo := newStatsRepository(changeDay)
+			s := newStatsService(t, repo)
+
+			days, err := s.ListDailyStats(context.Background(), ListDailyStatsParams{
+				RequesterID: testUserID,
+				Period:      AnalyticsPeriod{From: tt.from, To: tt.to},
+				Timezone:    "America/New_York",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantSince := time.Date(tt.from.Year(), tt.from.Month(), tt.from.Day(), 0, 0, 0, 0, newYork)
+			if !repo.params.Since.Equal(wantSince) || repo.params.Timezone != "America/New_York" {
+				t.Errorf("queried since %s in %s, want %s in America/New_York", repo.params.Since, repo.params.Timezone, wantSince)
+			}
+
+			if got := repo.params.Until.Sub(repo.params.Since).Hours(); got != tt.wantHours {
+				t.Errorf("period spans %v hours, want %v", got, tt.wantHours)
+			}
+
+			if len(days) != 3 {
+				t.Fatalf("got %d days, want 3", len(days))
+			}
+
+			for i, day := range days {
+				want := tt.from.AddDate(0, 0, i).Format(dateLayout)
+				if day.Date.Format(dateLayout) != want || day.Date.Hour() != 0 {
+					t.Errorf("day %d = %s, want midnight on %s", i, day.Date, want)
+				}
+			}
+
+			if days[1].Signups != 1 || days[1].Tweets != 2 || days[0].Signups != 0 || days[2].Tweets != 0 {
+				t.Errorf("days = %+v, want the counts on the day the clocks change only", days)
+			}
+		})
+	}
+}
+
+func TestListDailyStatsAdminRequired(t *testing.T) {
+	repo := newStatsRepository(time.Now())
+	s := newStatsService(t, repo)
+
+	for _, requesterID := range []string{"", "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01"} {
+		_, err := s.ListDailyStats(context.Background(), ListDailyStatsParams{RequesterID: requesterID})
+		if !errors.Is(err, ErrAdminRequired) {
+			t.Errorf("ListDailyStats() for %q error = %v, want ErrAdminRequired", requesterID, err)
+		}
+	}
+
+	if repo.queries != 0 {
+		t.Errorf("stats were queried %d times for non-admins", repo.queries)
+	}
+}
+
+func TestListDailyStatsCache(t *testing.T) {
+	repo := newStatsRepository(time.Now())
+	s := newStatsService(t, repo)
+
+	for _, tz := range []string{"", "", "Asia/Tokyo"} {
+		if _, err := s.ListDailyStats(context.Background(), ListDailyStatsParams{RequesterID: testUserID, Timezone: tz}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if repo.queries != 2 {
+		t.Errorf("stats were queried %d times, want once per time zone", repo.queries)
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+// Follower growth intervals, weeks start on Monday
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+)
+
+type ListFollowerGrowthParams struct {
+	UserID string
+	Period AnalyticsPeriod
+	// Interval defaults to IntervalDay
+	Interval string
+}
+
+func (s *service) ListFollowerGrowth(ctx context.Context, params ListFollowerGrowthParams) ([]models.FollowerGrowthBucket, error) {
+	user, err := s.FindUserByID(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last, err := params.Period.resolve(time.Now().In(userLocation(user)))
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.repository.ListFollowerDays(ctx, repository.ListFollowerDaysParams{
+		UserID:   user.ID,
+		Timezone: first.Location().String(),
+		Since:    first,
+		Until:    last.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	followersBefore, err := s.repository.CountFollowersBefore(ctx, user.ID, first)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketFollowerGrowth(days, followersBefore, first, last, params.Interval), nil
+}
+
+// bucketFollowerGrowth groups the followers gained each day from first to
+// last into buckets of interval, followersBefore is how many were gained
+// before first. The first bucket starts at first even if its interval
+// started earlier
+func bucketFollowerGrowth(days []repository.FollowerDay, followersBefore int, first, last time.Time, interval string) []models.FollowerGrowthBucket {
+	newFollowers := make(map[string]int, len(days))
+	for _, day := range days {
+		newFollowers[day.Date.Format(dateLayout)] = day.NewFollowers
+	}
+
+	var buckets []models.FollowerGrowthBucket
+
+	followers := followersBefore
+
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		start := intervalStart(date, interval)
+		if start.Before(first) {
+			start = first
+		}
+
+		if len(buckets) == 0 || !buckets[len(buckets)-1].Start.Equal(start) {
+			buckets = append(buckets, models.FollowerGrowthBucket{Start: start})
+		}
+
+		followers += newFollowers[date.Format(dateLayout)]
+
+		bucket := &buckets[len(buckets)-1]
+		bucket.NewFollowers += newFollowers[date.Format(dateLayout)]
+		bucket.Followers = followers
+	}
+
+	return buckets
+}
+
+// intervalStart returns the first day of the interval date falls in
+func intervalStart(date time.Time, interval string) time.Time {
+	switch interval {
+	case IntervalWeek:
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case IntervalMonth:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	default:
+		return date
+	}
+}
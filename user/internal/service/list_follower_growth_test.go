@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestBucketFollowerGrowth(t *testing.T) {
+	// Wednesday 2022-09-28 to Wednesday 2022-10-12
+	first, last := date(2022, 9, 28), date(2022, 10, 12)
+
+	days := []repository.FollowerDay{
+		{Date: date(2022, 9, 28), NewFollowers: 1},
+		{Date: date(2022, 10, 2), NewFollowers: 2},
+		{Date: date(2022, 10, 3), NewFollowers: 3},
+		{Date: date(2022, 10, 12), NewFollowers: 4},
+	}
+
+	type bucket struct {
+		start        string
+		newFollowers int
+		followers    int
+	}
+
+	tests := map[string][]bucket{
+		IntervalWeek: {
+			{"2022-09-28", 3, 13},
+			{"2022-10-03", 3, 16},
+			{"2022-10-10", 4, 20},
+		},
+		IntervalMonth: {
+			{"2022-09-28", 1, 11},
+			{"2022-10-01", 9, 20},
+		},
+	}
+
+	for interval, want := range tests {
+		t.Run(interval, func(t *testing.T) {
+			buckets := bucketFollowerGrowth(days, 10, first, last, interval)
+
+			if len(buckets) != len(want) {
+				t.Fatalf("got %d buckets, want %d", len(buckets), len(want))
+			}
+
+			for i, b := range buckets {
+				got := bucket{b.Start.Format(dateLayout), b.NewFollowers, b.Followers}
+				if got != want[i] {
+					t.Errorf("bucket %d = %+v, want %+v", i, got, want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBucketFollowerGrowthDays(t *testing.T) {
+	first, last := date(2022, 10, 1), date(2022, 10, 3)
+
+	buckets := bucketFollowerGrowth([]repository.FollowerDay{
+		{Date: date(2022, 10, 2), NewFollowers: 2},
+	}, 5, first, last, IntervalDay)
+
+	want := []int{5, 7, 7}
+
+	if len(buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d", len(buckets), len(want))
+	}
+
+	for i, b := range buckets {
+		if !b.Start.Equal(first.AddDate(0, 0, i)) || b.Followers != want[i] {
+			t.Errorf("bucket %d = %s with %d followers, want %s with %d",
+				i, b.Start.Format(dateLayout), b.Followers, first.AddDate(0, 0, i).Format(dateLayout), want[i])
+		}
+	}
+}
+
+func TestAnalyticsPeriodResolve(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := time.Date(2022, 10, 12, 1, 0, 0, 0, tokyo)
+
+	tests := map[string]struct {
+		period    AnalyticsPeriod
+		wantFirst string
+		wantLast  string
+		wantErr   error
+	}{
+		"defaults":       {wantFirst: "2022-09-13", wantLast: "2022-10-12"},
+		"only from":      {period: AnalyticsPeriod{From: date(2022, 10, 1)}, wantFirst: "2022-10-01", wantLast: "2022-10-12"},
+		"only to":        {period: AnalyticsPeriod{To: date(2022, 1, 30)}, wantFirst: "2022-01-01", wantLast: "2022-01-30"},
+		"single day":     {period: AnalyticsPeriod{From: date(2022, 1, 1), To: date(2022, 1, 1)}, wantFirst: "2022-01-01", wantLast: "2022-01-01"},
+		"366 days":       {period: AnalyticsPeriod{From: date(2021, 1, 1), To: date(2022, 1, 1)}, wantFirst: "2021-01-01", wantLast: "2022-01-01"},
+		"367 days":       {period: AnalyticsPeriod{From: date(2021, 1, 1), To: date(2022, 1, 2)}, wantErr: ErrInvalidPeriod},
+		"to before from": {period: AnalyticsPeriod{From: date(2022, 1, 2), To: date(2022, 1, 1)}, wantErr: ErrInvalidPeriod},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			first, last, err := tt.period.resolve(now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolve() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if first.Format(dateLayout) != tt.wantFirst || last.Format(dateLayout) != tt.wantLast {
+				t.Errorf("period = %s to %s, want %s to %s",
+					first.Format(dateLayout), last.Format(dateLayout), tt.wantFirst, tt.wantLast)
+			}
+
+			if first.Location() != tokyo || first.Hour() != 0 {
+				t.Errorf("first day starts at %s, want midnight in Asia/Tokyo", first)
+			}
+		})
+	}
+}
+
+func TestListFollowerGrowth(t *testing.T) {
+	user := models.User{ID: "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01", Timezone: "Asia/Tokyo"}
+
+	repo := &fakeRepository{}
+	repo.findUserByID = func(id string) (models.User, error) {
+		return user, nil
+	}
+
+	var since time.Time
+	repo.listFollowerDays = func(params repository.ListFollowerDaysParams) ([]repository.FollowerDay, error) {
+		since = params.Since
+		return []repository.FollowerDay{{Date: date(2022, 10, 2), NewFollowers: 2}}, nil
+	}
+	repo.countFollowersBefore = func(userID string, before time.Time) (int, error) {
+		return 3, nil
+	}
+
+	s := newTestService(t, repo)
+
+	buckets, err := s.ListFollowerGrowth(context.Background(), ListFollowerGrowthParams{
+		UserID: user.ID,
+		Period: AnalyticsPeriod{From: date(2022, 10, 1), To: date(2022, 10, 3)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Midnight in Tokyo is 15:00 UTC the day before
+	if want := time.Date(2022, 9, 30, 15, 0, 0, 0, time.UTC); !since.Equal(want) {
+		t.Errorf("since = %s, want %s", since.UTC(), want)
+	}
+
+	if len(buckets) != 3 || buckets[2].Followers != 5 {
+		t.Errorf("buckets = %+v, want 3 days ending with 5 followers", buckets)
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+const (
+	defaultInvitesLimit = 20
+	maxInvitesLimit     = 100
+)
+
+// ErrAdminRequired is returned when a user who isn't an admin calls an admin
+// only method
+var ErrAdminRequired = errors.New("admin required")
+
+type ListInvitesParams struct {
+	// RequesterID is the authenticated user, empty when anonymous
+	RequesterID string
+	// InviterID is optional, every invite is listed when empty
+	InviterID string
+	Limit     int
+	Cursor    string
+}
+
+func (s *service) ListInvites(ctx context.Context, params ListInvitesParams) (pagination.Page[models.InviteCode], error) {
+	if !s.isAdmin(params.RequesterID) {
+		return pagination.Page[models.InviteCode]{}, ErrAdminRequired
+	}
+
+	limit := pagination.ClampLimit(params.Limit, defaultInvitesLimit, maxInvitesLimit)
+
+	repoParams := repository.ListInviteCodesParams{
+		InviterID: params.InviterID,
+		// Fetch one extra row to know whether there is a next page
+		Limit: limit + 1,
+	}
+
+	if params.Cursor != "" {
+		cursor, err := s.cursors.Decode(params.Cursor)
+		if err != nil {
+			return pagination.Page[models.InviteCode]{}, err
+		}
+
+		repoParams.After = &cursor
+	}
+
+	invites, err := s.repository.ListInviteCodes(ctx, repoParams)
+	if err != nil {
+		return pagination.Page[models.InviteCode]{}, err
+	}
+
+	return pagination.NewPage(s.cursors, invites, limit, func(invite models.InviteCode) pagination.Cursor {
+		return pagination.Cursor{Time: invite.CreatedAt, ID: invite.Code}
+	}), nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+const (
+	defaultMutualFollowsLimit = 20
+	maxMutualFollowsLimit     = 100
+)
+
+type ListMutualFollowsParams struct {
+	ViewerID string
+	UserID   string
+	Limit    int
+	Cursor   string
+}
+
+func (s *service) ListMutualFollows(ctx context.Context, params ListMutualFollowsParams) (pagination.Page[models.User], error) {
+	limit := pagination.ClampLimit(params.Limit, defaultMutualFollowsLimit, maxMutualFollowsLimit)
+
+	repoParams := repository.ListMutualFollowsParams{
+		ViewerID: params.ViewerID,
+		UserID:   params.UserID,
+		// Fetch one extra row to know whether there is a next page
+		Limit: limit + 1,
+	}
+
+	if params.Cursor != "" {
+		cursor, err := s.cursors.Decode(params.Cursor)
+		if err != nil {
+			return pagination.Page[models.User]{}, err
+		}
+
+		repoParams.After = &cursor
+	}
+
+	follows, err := s.repository.ListMutualFollows(ctx, repoParams)
+	if err != nil {
+		return pagination.Page[models.User]{}, err
+	}
+
+	page := pagination.NewPage(s.cursors, follows, limit, func(follow repository.MutualFollow) pagination.Cursor {
+		return pagination.Cursor{Time: follow.FollowedAt, ID: follow.User.ID}
+	})
+
+	return pagination.Map(page, func(follow repository.MutualFollow) models.User {
+		return s.withDefaultProfileImage(follow.User)
+	}), nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+const (
+	defaultTopTweetsLimit = 10
+	maxTopTweetsLimit     = 50
+)
+
+type ListTopTweetsParams struct {
+	UserID string
+	Period AnalyticsPeriod
+	Limit  int
+	Cursor string
+}
+
+func (s *service) ListTopTweets(ctx context.Context, params ListTopTweetsParams) (pagination.Page[models.TopTweet], error) {
+	user, err := s.FindUserByID(ctx, params.UserID)
+	if err != nil {
+		return pagination.Page[models.TopTweet]{}, err
+	}
+
+	first, last, err := params.Period.resolve(time.Now().In(userLocation(user)))
+	if err != nil {
+		return pagination.Page[models.TopTweet]{}, err
+	}
+
+	limit := pagination.ClampLimit(params.Limit, defaultTopTweetsLimit, maxTopTweetsLimit)
+
+	repoParams := repository.ListTopTweetsParams{
+		UserID: user.ID,
+		Since:  first,
+		Until:  last.AddDate(0, 0, 1),
+		// Fetch one extra row to know whether there is a next page
+		Limit: limit + 1,
+	}
+
+	if params.Cursor != "" {
+		cursor, err := s.cursors.Decode(params.Cursor)
+		if err != nil {
+			return pagination.Page[models.TopTweet]{}, err
+		}
+
+		repoParams.After = &cursor
+	}
+
+	tweets, err := s.repository.ListTopTweets(ctx, repoParams)
+	if err != nil {
+		return pagination.Page[models.TopTweet]{}, err
+	}
+
+	// Engagement keeps changing between pages, a tweet liked in between can
+	// show up twice or be skipped
+	return pagination.NewPage(s.cursors, tweets, limit, func(tweet models.TopTweet) pagination.Cursor {
+		return pagination.Cursor{Score: int64(tweet.Engagement()), Time: tweet.CreatedAt, ID: tweet.ID}
+	}), nil
+}
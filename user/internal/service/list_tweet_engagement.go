@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+	"github.com/google/uuid"
+)
+
+// ErrNotAuthor is returned when someone other than the author of a tweet
+// asks for its analytics
+var ErrNotAuthor = errors.New("not the author")
+
+type ListTweetEngagementParams struct {
+	TweetID string
+	// RequesterID must be the author of the tweet
+	RequesterID string
+	Period      AnalyticsPeriod
+}
+
+func (s *service) ListTweetEngagement(ctx context.Context, params ListTweetEngagementParams) ([]models.TweetEngagementDay, error) {
+	authorID, err := s.repository.FindTweetAuthorID(ctx, params.TweetID)
+	if err != nil {
+		return nil, err
+	}
+
+	requesterID, err := uuid.Parse(params.RequesterID)
+	if err != nil || requesterID.String() != authorID {
+		return nil, ErrNotAuthor
+	}
+
+	author, err := s.FindUserByID(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last, err := params.Period.resolve(time.Now().In(userLocation(author)))
+	if err != nil {
+		return nil, err
+	}
+
+	engagementDays, err := s.repository.ListTweetEngagementDays(ctx, repository.ListTweetEngagementDaysParams{
+		TweetID:  params.TweetID,
+		Timezone: first.Location().String(),
+		Since:    first,
+		Until:    last.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	engagement := make(map[string]models.TweetEngagementDay, len(engagementDays))
+	for _, day := range engagementDays {
+		engagement[day.Date.Format(dateLayout)] = day
+	}
+
+	// Fill the days without engagement so clients get a dense series
+	var days []models.TweetEngagementDay
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		day := engagement[date.Format(dateLayout)]
+		day.Date = date
+		days = append(days, day)
+	}
+
+	return days, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+// engagementRepository knows a tweet by testUserID that got engagement on
+// the first and third day of March 2026
+type engagementRepository struct {
+	fakeRepository
+
+	params repository.ListTweetEngagementDaysParams
+}
+
+func newEngagementRepository() *engagementRepository {
+	r := &engagementRepository{}
+
+	r.findTweetAuthorID = func(tweetID string) (string, error) {
+		return testUserID, nil
+	}
+
+	r.findUserByID = func(id string) (models.User, error) {
+		return models.User{ID: id, Timezone: "Asia/Tokyo"}, nil
+	}
+
+	r.listTweetEngagementDays = func(params repository.ListTweetEngagementDaysParams) ([]models.TweetEngagementDay, error) {
+		r.params = params
+
+		return []models.TweetEngagementDay{
+			{Date: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Likes: 3, Replies: 1},
+			{Date: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), Retweets: 2},
+		}, nil
+	}
+
+	return r
+}
+
+func TestListTweetEngagement(t *testing.T) {
+	repo := newEngagementRepository()
+	s := newTestService(t, repo)
+
+	days, err := s.ListTweetEngagement(context.Background(), ListTweetEngagementParams{
+		TweetID:     "5b0f1c7e-2d4a-4e8b-9c3f-6a1d2e3f4a5b",
+		RequesterID: strings.ToUpper(testUserID),
+		Period: AnalyticsPeriod{
+			From: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	if want := time.Date(2026, 3, 1, 0, 0, 0, 0, tokyo); !repo.params.Since.Equal(want) || repo.params.Timezone != "Asia/Tokyo" {
+		t.Errorf("queried since %s in %s, want %s in the author's time zone", repo.params.Since, repo.params.Timezone, want)
+	}
+
+	want := []models.TweetEngagementDay{
+		{Likes: 3, Replies: 1},
+		{},
+		{Retweets: 2},
+		{},
+	}
+
+	if len(days) != len(want) {
+		t.Fatalf("got %d days, want %d", len(days), len(want))
+	}
+
+	for i, day := range days {
+		date := day.Date.Format(dateLayout)
+		day.Date = time.Time{}
+
+		if day != want[i] {
+			t.Errorf("%s = %+v, want %+v", date, day, want[i])
+		}
+	}
+}
+
+func TestListTweetEngagementNotAuthor(t *testing.T) {
+	s := newTestService(t, newEngagementRepository())
+
+	_, err := s.ListTweetEngagement(context.Background(), ListTweetEngagementParams{
+		TweetID:     "5b0f1c7e-2d4a-4e8b-9c3f-6a1d2e3f4a5b",
+		RequesterID: "0d0c5a1e-5a52-4c3e-9d3f-3c1f0c2b7a01",
+	})
+	if !errors.Is(err, ErrNotAuthor) {
+		t.Errorf("ListTweetEngagement() error = %v, want ErrNotAuthor", err)
+	}
+}
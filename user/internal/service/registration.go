@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	// ErrRegistrationClosed is returned when signing up while registration
+	// is closed without an invite
+	ErrRegistrationClosed = errors.New("registration is closed")
+
+	// ErrInvalidInvite is returned when signing up with an invite code that
+	// doesn't exist, has been used up or has expired
+	ErrInvalidInvite = errors.New("invalid invite")
+)
+
+func (s *service) RegistrationOpen() bool {
+	return s.registration.Open
+}
+
+// checkRegistration decides whether a new user may sign up with the given
+// invite token. It returns the invite code to redeem along with the signup,
+// empty when there is none. Invite codes are only enforced while
+// registration is closed, while it is open a code just credits the inviter
+// and signing up doesn't depend on it
+func (s *service) checkRegistration(inviteToken string) (string, error) {
+	if inviteToken == "" {
+		if s.registration.Open {
+			return "", nil
+		}
+
+		return "", ErrRegistrationClosed
+	}
+
+	for _, token := range s.registration.InviteTokens {
+		if token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(inviteToken)) == 1 {
+			return "", nil
+		}
+	}
+
+	// Invite codes are generated uppercase, accept them however they're typed
+	return strings.ToUpper(inviteToken), nil
+}
+
+func (s *service) CheckInvite(ctx context.Context, inviteToken string) error {
+	if s.registration.Open {
+		return nil
+	}
+
+	code, err := s.checkRegistration(inviteToken)
+	if err != nil || code == "" {
+		return err
+	}
+
+	invite, err := s.repository.FindInviteCode(ctx, code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidInvite
+	}
+	if err != nil {
+		return err
+	}
+
+	if !invite.Usable(time.Now().UTC()) {
+		return ErrInvalidInvite
+	}
+
+	return nil
+}
+
+// isAdmin reports whether the user is one of the configured admins, userID
+// must come from an authenticated identity rather than a request body
+func (s *service) isAdmin(userID string) bool {
+	if userID == "" {
+		return false
+	}
+
+	for _, id := range s.registration.AdminIDs {
+		if strings.EqualFold(id, userID) {
+			return true
+		}
+	}
+
+	return false
+}
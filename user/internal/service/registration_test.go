@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/jackc/pgx/v4"
+)
+
+// signupRepository records how a user got created and redeems invite codes
+// like the real repository does
+type signupRepository struct {
+	fakeRepository
+
+	invites    map[string]*models.InviteCode
+	created    bool
+	inviteCode string
+}
+
+func newSignupRepository(invites ...models.InviteCode) *signupRepository {
+	r := &signupRepository{invites: make(map[string]*models.InviteCode)}
+
+	for i := range invites {
+		r.invites[invites[i].Code] = &invites[i]
+	}
+
+	r.createUser = func(user models.User) (models.User, error) {
+		r.created = true
+		return user, nil
+	}
+
+	r.createUserWithInvite = func(user models.User, code string) (models.User, error) {
+		invite, ok := r.invites[code]
+		if !ok || !invite.Usable(user.CreatedAt) {
+			return models.User{}, pgx.ErrNoRows
+		}
+
+		invite.Uses++
+		r.created = true
+		r.inviteCode = code
+		return user, nil
+	}
+
+	r.findUserByEmailFromWriter = func(email string) (models.User, error) {
+		return models.User{}, pgx.ErrNoRows
+	}
+
+	r.findInviteCode = func(code string) (models.InviteCode, error) {
+		invite, ok := r.invites[code]
+		if !ok {
+			return models.InviteCode{}, pgx.ErrNoRows
+		}
+
+		return *invite, nil
+	}
+
+	return r
+}
+
+// testInvites are a usable, a used up and an expired invite code
+func testInvites() []models.InviteCode {
+	now := time.Now()
+
+	return []models.InviteCode{
+		{Code: "VALID", MaxUses: 2, Uses: 1, ExpiresAt: now.Add(time.Hour)},
+		{Code: "USEDUP", MaxUses: 2, Uses: 2, ExpiresAt: now.Add(time.Hour)},
+		{Code: "EXPIRED", MaxUses: 1, ExpiresAt: now.Add(-time.Hour)},
+	}
+}
+
+func signupParams(inviteToken string) CreateUserParams {
+	return CreateUserParams{
+		Name:        "John",
+		ScreenName:  "john",
+		RawPassword: "password",
+		Email:       "john@example.com",
+		InviteToken: inviteToken,
+	}
+}
+
+func TestCreateUserRegistrationClosed(t *testing.T) {
+	repo := newSignupRepository()
+	s := newTestService(t, repo)
+	s.registration.Open = false
+
+	_, err := s.CreateUser(context.Background(), signupParams(""))
+	if !errors.Is(err, ErrRegistrationClosed) {
+		t.Errorf("CreateUser() error = %v, want ErrRegistrationClosed", err)
+	}
+
+	if repo.created {
+		t.Error("a user was created while registration is closed")
+	}
+}
+
+func TestCreateUserInviteTokenBypass(t *testing.T) {
+	repo := newSignupRepository()
+	s := newTestService(t, repo)
+	s.registration.Open = false
+	s.registration.InviteTokens = []string{"beta-token"}
+
+	if _, err := s.CreateUser(context.Background(), signupParams("beta-token")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	if !repo.created || repo.inviteCode != "" {
+		t.Errorf("created = %t with invite code %q, want a plain signup", repo.created, repo.inviteCode)
+	}
+}
+
+func TestCreateUserRegistrationOpen(t *testing.T) {
+	repo := newSignupRepository()
+	s := newTestService(t, repo)
+
+	if _, err := s.CreateUser(context.Background(), signupParams("")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	if !repo.created {
+		t.Error("no user was created while registration is open")
+	}
+}
+
+func TestCreateUserWithInviteCode(t *testing.T) {
+	tests := map[string]struct {
+		open     bool
+		token    string
+		wantErr  error
+		wantCode string
+	}{
+		"closed valid":            {token: "VALID", wantCode: "VALID"},
+		"closed valid lowercase":  {token: "valid", wantCode: "VALID"},
+		"closed used up":          {token: "USEDUP", wantErr: ErrInvalidInvite},
+		"closed expired":          {token: "EXPIRED", wantErr: ErrInvalidInvite},
+		"closed unknown":          {token: "NOPE", wantErr: ErrInvalidInvite},
+		"open valid is redeemed":  {open: true, token: "VALID", wantCode: "VALID"},
+		"open used up is ignored": {open: true, token: "USEDUP"},
+		"open expired is ignored": {open: true, token: "EXPIRED"},
+		"open unknown is ignored": {open: true, token: "NOPE"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := newSignupRepository(testInvites()...)
+			s := newTestService(t, repo)
+			s.registration.Open = tt.open
+
+			_, err := s.CreateUser(context.Background(), signupParams(tt.token))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateUser() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if repo.created != (tt.wantErr == nil) {
+				t.Errorf("created = %t, want %t", repo.created, tt.wantErr == nil)
+			}
+
+			if repo.inviteCode != tt.wantCode {
+				t.Errorf("redeemed %q, want %q", repo.inviteCode, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCreateUserUsesUpInviteCode(t *testing.T) {
+	repo := newSignupRepository(testInvites()...)
+	s := newTestService(t, repo)
+	s.registration.Open = false
+
+	// VALID has one use left
+	if _, err := s.CreateUser(context.Background(), signupParams("VALID")); err != nil {
+		t.Fatalf("first CreateUser() error = %v", err)
+	}
+
+	if _, err := s.CreateUser(context.Background(), signupParams("VALID")); !errors.Is(err, ErrInvalidInvite) {
+		t.Errorf("second CreateUser() error = %v, want ErrInvalidInvite", err)
+	}
+}
+
+func TestCreateUserStoresUTC(t *testing.T) {
+	repo := newSignupRepository()
+	s := newTestService(t, repo)
+
+	user, err := s.CreateUser(context.Background(), signupParams(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compared against invite_codes.expires_at when redeeming an invite
+	if user.CreatedAt.Location() != time.UTC {
+		t.Errorf("created at %s, want UTC", user.CreatedAt)
+	}
+}
+
+func TestCheckInvite(t *testing.T) {
+	tests := map[string]struct {
+		open    bool
+		token   string
+		wantErr error
+	}{
+		"closed valid":           {token: "VALID"},
+		"closed valid lowercase": {token: "valid"},
+		"closed invite token":    {token: "beta-token"},
+		"closed used up":         {token: "USEDUP", wantErr: ErrInvalidInvite},
+		"closed expired":         {token: "EXPIRED", wantErr: ErrInvalidInvite},
+		"closed unknown":         {token: "NOPE", wantErr: ErrInvalidInvite},
+		"closed no token":        {wantErr: ErrRegistrationClosed},
+		"open used up":           {open: true, token: "USEDUP"},
+		"open no token":          {open: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newTestService(t, newSignupRepository(testInvites()...))
+			s.registration.Open = tt.open
+			s.registration.InviteTokens = []string{"beta-token"}
+
+			if err := s.CheckInvite(context.Background(), tt.token); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckInvite() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
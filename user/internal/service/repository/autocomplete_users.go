@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/Masterminds/squirrel"
+)
+
+type AutocompleteUsersParams struct {
+	ViewerID string
+	// Prefix is matched case-insensitively against the start of the screen
+	// name and the name
+	Prefix string
+	Limit  int
+	// ExcludeIDs are left out of the result, used to skip users that were
+	// already suggested
+	ExcludeIDs []string
+}
+
+// likePrefix escapes the LIKE wildcards in prefix and turns it into a
+// lowercase prefix pattern
+func likePrefix(prefix string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(strings.ToLower(prefix)) + "%"
+}
+
+// matchesPrefix filters the users whose screen name or name start with the
+// given prefix, both are backed by a lower() text_pattern_ops index
+func matchesPrefix(prefix string) squirrel.Sqlizer {
+	pattern := likePrefix(prefix)
+
+	return squirrel.Or{
+		squirrel.Expr("lower(u.screen_name) LIKE ?", pattern),
+		squirrel.Expr("lower(u.name) LIKE ?", pattern),
+	}
+}
+
+func (r *repository) AutocompleteConnections(ctx context.Context, params AutocompleteUsersParams, followingOnly bool) ([]models.User, error) {
+	builder := r.queryBuilder.
+		Select(userColumnsOf("u")...).
+		From("users u").
+		LeftJoin("followers fi ON fi.followee_id = u.id AND fi.follower_id = ?", params.ViewerID).
+		LeftJoin("followers fo ON fo.followee_id = ? AND fo.follower_id = u.id", params.ViewerID).
+		Where(matchesPrefix(params.Prefix)).
+		OrderBy("fi.follower_id IS NULL", "u.followers_count DESC", "u.id").
+		Limit(uint64(params.Limit))
+
+	if followingOnly {
+		builder = builder.Where("fi.follower_id IS NOT NULL")
+	} else {
+		builder = builder.Where("(fi.follower_id IS NOT NULL OR fo.follower_id IS NOT NULL)")
+	}
+
+	if len(params.ExcludeIDs) > 0 {
+		builder = builder.Where(squirrel.NotEq{"u.id": params.ExcludeIDs})
+	}
+
+	query, args, _ := builder.ToSql()
+
+	return queryUsers(ctx, r.readerDB, query, args...)
+}
+
+func (r *repository) AutocompleteUsers(ctx context.Context, params AutocompleteUsersParams) ([]models.User, error) {
+	builder := r.queryBuilder.
+		Select(userColumnsOf("u")...).
+		From("users u").
+		Where(matchesPrefix(params.Prefix)).
+		OrderBy("u.followers_count DESC", "u.id").
+		Limit(uint64(params.Limit))
+
+	if len(params.ExcludeIDs) > 0 {
+		builder = builder.Where(squirrel.NotEq{"u.id": params.ExcludeIDs})
+	}
+
+	query, args, _ := builder.ToSql()
+
+	return queryUsers(ctx, r.readerDB, query, args...)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+func TestLikePrefix(t *testing.T) {
+	tests := map[string]string{
+		"Jo":    "jo%",
+		"50%":   `50\%%`,
+		"a_b":   `a\_b%`,
+		`back\`: `back\\%`,
+	}
+
+	for prefix, want := range tests {
+		if got := likePrefix(prefix); got != want {
+			t.Errorf("likePrefix(%q) = %q, want %q", prefix, got, want)
+		}
+	}
+}
+
+func TestAutocompleteTiers(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+	prefix := uniquePrefix()
+
+	viewer := createTestUser(t, r, prefix+"viewer", 0)
+	followed := createTestUser(t, r, prefix+"followed", 1)
+	follower := createTestUser(t, r, prefix+"follower", 50)
+	stranger := createTestUser(t, r, prefix+"stranger", 100)
+	createTestUser(t, r, "x"+prefix, 1000)
+
+	now := time.Now()
+	follow(t, db, viewer, followed, now)
+	follow(t, db, follower, viewer, now)
+
+	params := AutocompleteUsersParams{ViewerID: viewer.ID, Prefix: prefix, Limit: 10}
+
+	connections, err := r.AutocompleteConnections(ctx, params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Followed users come first even though the follower is more popular
+	if got, want := screenNames(connections), screenNames([]models.User{followed, follower}); got != want {
+		t.Errorf("connections = %s, want %s", got, want)
+	}
+
+	following, err := r.AutocompleteConnections(ctx, params, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := screenNames(following), followed.ScreenName; got != want {
+		t.Errorf("following only = %s, want %s", got, want)
+	}
+
+	params.ExcludeIDs = []string{viewer.ID, followed.ID, follower.ID}
+
+	others, err := r.AutocompleteUsers(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Names that only contain the prefix further in don't match
+	if got, want := screenNames(others), stranger.ScreenName; got != want {
+		t.Errorf("others = %s, want %s", got, want)
+	}
+}
@@ -9,6 +9,10 @@ import (
 type CreateUserParams struct{}
 
 func (r *repository) CreateUser(ctx context.Context, params models.User) (models.User, error) {
+	return r.createUser(ctx, r.writerDB, params)
+}
+
+func (r *repository) createUser(ctx context.Context, db querier, params models.User) (models.User, error) {
 	input := map[string]any{
 		"id":                 params.ID,
 		"name":               params.Name,
@@ -25,38 +29,18 @@ func (r *repository) CreateUser(ctx context.Context, params models.User) (models
 		"followings_count":   params.FollowingsCount,
 		"created_at":         params.CreatedAt,
 		"updated_at":         params.UpdatedAt,
+		"tweets_count":       params.TweetsCount,
+		"timezone":           params.Timezone,
 	}
 
 	query, args, err := r.queryBuilder.
 		Insert("users").
 		SetMap(input).
-		Suffix("RETURNING *").
+		Suffix(returningUserColumns()).
 		ToSql()
 	if err != nil {
 		return models.User{}, err
 	}
 
-	var user models.User
-
-	err = r.writerDB.QueryRow(ctx, query, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.ScreenName,
-		&user.PasswordHash,
-		&user.Email,
-		&user.Bio,
-		&user.Location,
-		&user.Website,
-		&user.BirthDate,
-		&user.ProfileImageURL,
-		&user.ProfileBannerURL,
-		&user.FollowersCount,
-		&user.FollowingsCount,
-		&user.CreatedAt,
-		&user.UpdatedAt)
-	if err != nil {
-		return models.User{}, err
-	}
-
-	return user, nil
+	return scanUser(db.QueryRow(ctx, query, args...))
 }
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *repository) CreateUserWithInvite(ctx context.Context, params models.User, code string) (models.User, error) {
+	tx, err := r.writerDB.Begin(ctx)
+	if err != nil {
+		return models.User{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	user, err := r.createUser(ctx, tx, params)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	query, args, _ := r.queryBuilder.
+		Update("invite_codes").
+		Set("uses", squirrel.Expr("uses + 1")).
+		Where(squirrel.Eq{"code": code}).
+		Where("uses < max_uses").
+		Where(squirrel.Gt{"expires_at": params.CreatedAt}).
+		Suffix("RETURNING inviter_id").
+		ToSql()
+
+	// Returns pgx.ErrNoRows when the code can't be redeemed
+	var inviterID string
+	if err := tx.QueryRow(ctx, query, args...).Scan(&inviterID); err != nil {
+		return models.User{}, err
+	}
+
+	query, args, _ = r.queryBuilder.
+		Insert("invite_redemptions").
+		SetMap(map[string]any{
+			"invitee_id": user.ID,
+			"inviter_id": inviterID,
+			"code":       code,
+			"created_at": params.CreatedAt,
+		}).
+		ToSql()
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return models.User{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.User{}, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+func createTestInvite(t *testing.T, r *repository, inviter models.User, maxUses int, expiresAt time.Time) models.InviteCode {
+	t.Helper()
+
+	invite, err := r.CreateInviteCode(context.Background(), models.InviteCode{
+		Code:      "T" + uuid.NewString()[:15],
+		InviterID: inviter.ID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt.UTC(),
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatalf("creating invite: %v", err)
+	}
+
+	return invite
+}
+
+func newInvitee(screenName string) models.User {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	return models.User{
+		ID:         uuid.NewString(),
+		Name:       screenName,
+		ScreenName: screenName,
+		Email:      screenName + "@example.com",
+		BirthDate:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		Timezone:   models.DefaultTimezone,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func TestCreateUserWithInvite(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+	prefix := uniquePrefix()
+
+	inviter := createTestUser(t, r, prefix+"inviter", 0)
+
+	valid := createTestInvite(t, r, inviter, 2, time.Now().Add(time.Hour))
+	expired := createTestInvite(t, r, inviter, 1, time.Now().Add(-time.Hour))
+
+	t.Cleanup(func() {
+		db.Exec(context.Background(), "DELETE FROM invite_redemptions WHERE inviter_id = $1", inviter.ID)
+		db.Exec(context.Background(), "DELETE FROM users WHERE screen_name LIKE $1", prefix+"%")
+	})
+
+	// valid has two uses, the third signup is refused
+	for i, name := range []string{"first", "second", "third"} {
+		_, err := r.CreateUserWithInvite(ctx, newInvitee(prefix+name), valid.Code)
+
+		if i < 2 && err != nil {
+			t.Fatalf("signup %d: %v", i+1, err)
+		}
+
+		if i == 2 && !errors.Is(err, pgx.ErrNoRows) {
+			t.Fatalf("signup with a used up code: err = %v, want pgx.ErrNoRows", err)
+		}
+	}
+
+	if _, err := r.CreateUserWithInvite(ctx, newInvitee(prefix+"late"), expired.Code); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("signup with an expired code: err = %v, want pgx.ErrNoRows", err)
+	}
+
+	// Refused signups are rolled back along with the redemption
+	var users int
+	if err := db.QueryRow(ctx, "SELECT COUNT(*) FROM users WHERE screen_name IN ($1, $2)", prefix+"third", prefix+"late").Scan(&users); err != nil {
+		t.Fatal(err)
+	}
+
+	if users != 0 {
+		t.Errorf("%d refused signups were kept", users)
+	}
+
+	found, err := r.FindInviteCode(ctx, valid.Code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if found.Uses != 2 {
+		t.Errorf("uses = %d, want 2", found.Uses)
+	}
+
+	// Redemptions outlive the inviter so the invite tree can still be traced
+	if _, err := db.Exec(ctx, "DELETE FROM users WHERE id = $1", inviter.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	var redemptions int
+	if err := db.QueryRow(ctx, "SELECT COUNT(*) FROM invite_redemptions WHERE inviter_id = $1", inviter.ID).Scan(&redemptions); err != nil {
+		t.Fatal(err)
+	}
+
+	if redemptions != 2 {
+		t.Errorf("%d redemptions left after deleting the inviter, want 2", redemptions)
+	}
+}
@@ -8,34 +8,19 @@ import (
 )
 
 func (r *repository) FindUserByEmail(ctx context.Context, email string) (models.User, error) {
+	return r.findUserByEmail(ctx, r.readerDB, email)
+}
+
+func (r *repository) FindUserByEmailFromWriter(ctx context.Context, email string) (models.User, error) {
+	return r.findUserByEmail(ctx, r.writerDB, email)
+}
+
+func (r *repository) findUserByEmail(ctx context.Context, db querier, email string) (models.User, error) {
 	query, args, _ := r.queryBuilder.
-		Select("*").
+		Select(userColumns...).
 		From("users").
 		Where(squirrel.Eq{"email": email}).
 		ToSql()
 
-	var user models.User
-
-	err := r.readerDB.QueryRow(ctx, query, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.ScreenName,
-		&user.PasswordHash,
-		&user.Email,
-		&user.Bio,
-		&user.Location,
-		&user.Website,
-		&user.BirthDate,
-		&user.ProfileImageURL,
-		&user.ProfileBannerURL,
-		&user.FollowersCount,
-		&user.FollowingsCount,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
-	if err != nil {
-		return models.User{}, err
-	}
-
-	return user, nil
+	return scanUser(db.QueryRow(ctx, query, args...))
 }
@@ -8,34 +8,19 @@ import (
 )
 
 func (r *repository) FindUserByID(ctx context.Context, id string) (models.User, error) {
+	return r.findUserByID(ctx, r.readerDB, id)
+}
+
+func (r *repository) FindUserByIDFromWriter(ctx context.Context, id string) (models.User, error) {
+	return r.findUserByID(ctx, r.writerDB, id)
+}
+
+func (r *repository) findUserByID(ctx context.Context, db querier, id string) (models.User, error) {
 	query, args, _ := r.queryBuilder.
-		Select("*").
+		Select(userColumns...).
 		From("users").
 		Where(squirrel.Eq{"id": id}).
 		ToSql()
 
-	var user models.User
-
-	err := r.readerDB.QueryRow(ctx, query, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.ScreenName,
-		&user.PasswordHash,
-		&user.Email,
-		&user.Bio,
-		&user.Location,
-		&user.Website,
-		&user.BirthDate,
-		&user.ProfileImageURL,
-		&user.ProfileBannerURL,
-		&user.FollowersCount,
-		&user.FollowingsCount,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
-	if err != nil {
-		return models.User{}, err
-	}
-
-	return user, nil
+	return scanUser(db.QueryRow(ctx, query, args...))
 }
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *repository) FindUsersByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	return r.findUsersByIDs(ctx, r.readerDB, ids)
+}
+
+func (r *repository) FindUsersByIDsFromWriter(ctx context.Context, ids []string) ([]models.User, error) {
+	return r.findUsersByIDs(ctx, r.writerDB, ids)
+}
+
+func (r *repository) findUsersByIDs(ctx context.Context, db querier, ids []string) ([]models.User, error) {
+	query, args, _ := r.queryBuilder.
+		Select(userColumns...).
+		From("users").
+		Where(squirrel.Eq{"id": ids}).
+		ToSql()
+
+	return queryUsers(ctx, db, query, args...)
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/Masterminds/squirrel"
+)
+
+// inviteCodeColumns are the columns scanned into models.InviteCode, in order
+var inviteCodeColumns = []string{"code", "inviter_id", "max_uses", "uses", "expires_at", "created_at"}
+
+type ListInviteCodesParams struct {
+	// InviterID is optional, all invite codes are listed when empty
+	InviterID string
+	Limit     int
+
+	// After is the position of the last code on the previous page, nil on
+	// the first page
+	After *pagination.Cursor
+}
+
+func (r *repository) CreateInviteCode(ctx context.Context, params models.InviteCode) (models.InviteCode, error) {
+	query, args, _ := r.queryBuilder.
+		Insert("invite_codes").
+		SetMap(map[string]any{
+			"code":       params.Code,
+			"inviter_id": params.InviterID,
+			"max_uses":   params.MaxUses,
+			"expires_at": params.ExpiresAt,
+			"created_at": params.CreatedAt,
+		}).
+		Suffix("RETURNING code, inviter_id, max_uses, uses, expires_at, created_at").
+		ToSql()
+
+	var invite models.InviteCode
+
+	err := r.writerDB.QueryRow(ctx, query, args...).Scan(
+		&invite.Code,
+		&invite.InviterID,
+		&invite.MaxUses,
+		&invite.Uses,
+		&invite.ExpiresAt,
+		&invite.CreatedAt,
+	)
+	if err != nil {
+		return models.InviteCode{}, err
+	}
+
+	return invite, nil
+}
+
+func (r *repository) FindInviteCode(ctx context.Context, code string) (models.InviteCode, error) {
+	query, args, _ := r.queryBuilder.
+		Select(inviteCodeColumns...).
+		From("invite_codes").
+		Where(squirrel.Eq{"code": code}).
+		ToSql()
+
+	var invite models.InviteCode
+
+	// Read from the writer so codes generated moments ago are found
+	err := r.writerDB.QueryRow(ctx, query, args...).Scan(
+		&invite.Code,
+		&invite.InviterID,
+		&invite.MaxUses,
+		&invite.Uses,
+		&invite.ExpiresAt,
+		&invite.CreatedAt,
+	)
+	if err != nil {
+		return models.InviteCode{}, err
+	}
+
+	return invite, nil
+}
+
+func (r *repository) ListInviteCodes(ctx context.Context, params ListInviteCodesParams) ([]models.InviteCode, error) {
+	builder := r.queryBuilder.
+		Select(inviteCodeColumns...).
+		From("invite_codes").
+		OrderBy("created_at DESC", "code DESC").
+		Limit(uint64(params.Limit))
+
+	if params.InviterID != "" {
+		builder = builder.Where(squirrel.Eq{"inviter_id": params.InviterID})
+	}
+
+	if params.After != nil {
+		builder = builder.Where(pagination.Before("created_at", "code", *params.After))
+	}
+
+	query, args, _ := builder.ToSql()
+
+	rows, err := r.readerDB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []models.InviteCode
+
+	for rows.Next() {
+		var invite models.InviteCode
+
+		err := rows.Scan(
+			&invite.Code,
+			&invite.InviterID,
+			&invite.MaxUses,
+			&invite.Uses,
+			&invite.ExpiresAt,
+			&invite.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		invites = append(invites, invite)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+func (r *repository) CountInviteCodesSince(ctx context.Context, inviterID string, since time.Time) (int, error) {
+	query, args, _ := r.queryBuilder.
+		Select("COUNT(*)").
+		From("invite_codes").
+		Where(squirrel.Eq{"inviter_id": inviterID}).
+		Where(squirrel.GtOrEq{"created_at": since}).
+		ToSql()
+
+	var count int
+
+	// Read from the writer so codes generated moments ago are counted
+	if err := r.writerDB.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
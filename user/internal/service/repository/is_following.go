@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *repository) IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error) {
+	query, args, _ := r.queryBuilder.
+		Select("1").
+		From("followers").
+		Where(squirrel.Eq{"followee_id": followeeID, "follower_id": followerID}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+
+	var exists bool
+
+	if err := r.readerDB.QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
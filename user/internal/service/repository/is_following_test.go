@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestIsFollowing(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+	prefix := uniquePrefix()
+
+	alice := createTestUser(t, r, prefix+"alice", 0)
+	bob := createTestUser(t, r, prefix+"bob", 0)
+	carol := createTestUser(t, r, prefix+"carol", 0)
+
+	// alice and bob follow each other, carol follows alice one-way
+	now := time.Now()
+	follow(t, db, alice, bob, now)
+	follow(t, db, bob, alice, now)
+	follow(t, db, carol, alice, now)
+
+	tests := map[string]struct {
+		follower, followee string
+		want               bool
+	}{
+		"mutual":          {follower: alice.ID, followee: bob.ID, want: true},
+		"mutual reversed": {follower: bob.ID, followee: alice.ID, want: true},
+		"one-way":         {follower: carol.ID, followee: alice.ID, want: true},
+		"one-way reverse": {follower: alice.ID, followee: carol.ID},
+		"no follow":       {follower: bob.ID, followee: carol.ID},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := r.IsFollowing(ctx, tt.follower, tt.followee)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("IsFollowing = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+// listActivityDaysQuery buckets every event by day in the given time zone,
+// created_at columns hold UTC wall-clock times. Self-likes, self-replies and
+// likes without a known time are left out
+const listActivityDaysQuery = `
+SELECT
+	e.day,
+	count(*) FILTER (WHERE e.kind = 'tweet'),
+	count(*) FILTER (WHERE e.kind = 'like'),
+	count(*) FILTER (WHERE e.kind = 'reply'),
+	count(*) FILTER (WHERE e.kind = 'follower')
+FROM (
+	SELECT date_trunc('day', t.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date AS day, 'tweet' AS kind
+	FROM tweets t
+	WHERE t.user_id = $2 AND t.created_at >= $3
+
+	UNION ALL
+
+	SELECT date_trunc('day', f.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, 'like'
+	FROM favorites f
+	JOIN tweets t ON t.id = f.tweet_id
+	WHERE t.user_id = $2 AND f.user_id <> $2 AND f.created_at >= $3
+
+	UNION ALL
+
+	SELECT date_trunc('day', rt.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, 'reply'
+	FROM replies r
+	JOIN tweets t ON t.id = r.tweet_id
+	JOIN tweets rt ON rt.id = r.reply_id
+	WHERE t.user_id = $2 AND rt.user_id <> $2 AND rt.created_at >= $3
+
+	UNION ALL
+
+	SELECT date_trunc('day', fl.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, 'follower'
+	FROM followers fl
+	WHERE fl.followee_id = $2 AND fl.created_at >= $3
+) e
+GROUP BY e.day
+ORDER BY e.day`
+
+type ListActivityDaysParams struct {
+	UserID   string
+	Timezone string
+	// Since is the UTC instant the first day starts at
+	Since time.Time
+}
+
+func (r *repository) ListActivityDays(ctx context.Context, params ListActivityDaysParams) ([]models.ActivityDay, error) {
+	rows, err := r.readerDB.Query(ctx, listActivityDaysQuery, params.Timezone, params.UserID, params.Since.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []models.ActivityDay
+
+	for rows.Next() {
+		var day models.ActivityDay
+
+		err := rows.Scan(
+			&day.Date,
+			&day.Tweets,
+			&day.LikesReceived,
+			&day.RepliesReceived,
+			&day.NewFollowers,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+// listDailyStatsQuery buckets signups and tweets by day in the given time
+// zone, created_at columns hold UTC wall-clock times
+const listDailyStatsQuery = `
+SELECT
+	e.day,
+	count(*) FILTER (WHERE e.kind = 'signup'),
+	count(*) FILTER (WHERE e.kind = 'tweet')
+FROM (
+	SELECT date_trunc('day', u.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date AS day, 'signup' AS kind
+	FROM users u
+	WHERE u.created_at >= $2 AND u.created_at < $3
+
+	UNION ALL
+
+	SELECT date_trunc('day', t.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, 'tweet'
+	FROM tweets t
+	WHERE t.created_at >= $2 AND t.created_at < $3
+) e
+GROUP BY e.day
+ORDER BY e.day`
+
+type ListDailyStatsParams struct {
+	Timezone string
+	// Since and Until are the UTC instants the period starts and ends at
+	Since time.Time
+	Until time.Time
+}
+
+func (r *repository) ListDailyStats(ctx context.Context, params ListDailyStatsParams) ([]models.DailyStat, error) {
+	rows, err := r.readerDB.Query(ctx, listDailyStatsQuery, params.Timezone, params.Since.UTC(), params.Until.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []models.DailyStat
+
+	for rows.Next() {
+		var day models.DailyStat
+
+		if err := rows.Scan(&day.Date, &day.Signups, &day.Tweets); err != nil {
+			return nil, err
+		}
+
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
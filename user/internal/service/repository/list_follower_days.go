@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// listFollowerDaysQuery buckets new followers by day in the given time zone,
+// created_at columns hold UTC wall-clock times
+const listFollowerDaysQuery = `
+SELECT date_trunc('day', created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, count(*)
+FROM followers
+WHERE followee_id = $2 AND created_at >= $3 AND created_at < $4
+GROUP BY 1
+ORDER BY 1`
+
+type ListFollowerDaysParams struct {
+	UserID   string
+	Timezone string
+	// Since and Until are the UTC instants the period starts and ends at
+	Since time.Time
+	Until time.Time
+}
+
+// FollowerDay is how many followers a user gained on a single day in their
+// time zone
+type FollowerDay struct {
+	Date         time.Time
+	NewFollowers int
+}
+
+func (r *repository) ListFollowerDays(ctx context.Context, params ListFollowerDaysParams) ([]FollowerDay, error) {
+	rows, err := r.readerDB.Query(ctx, listFollowerDaysQuery, params.Timezone, params.UserID, params.Since.UTC(), params.Until.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []FollowerDay
+
+	for rows.Next() {
+		var day FollowerDay
+
+		if err := rows.Scan(&day.Date, &day.NewFollowers); err != nil {
+			return nil, err
+		}
+
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+func (r *repository) CountFollowersBefore(ctx context.Context, userID string, before time.Time) (int, error) {
+	query, args, _ := r.queryBuilder.
+		Select("count(*)").
+		From("followers").
+		Where(squirrel.Eq{"followee_id": userID}).
+		Where(squirrel.Lt{"created_at": before.UTC()}).
+		ToSql()
+
+	var count int
+	err := r.readerDB.QueryRow(ctx, query, args...).Scan(&count)
+
+	return count, err
+}
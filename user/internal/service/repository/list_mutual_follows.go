@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+)
+
+type ListMutualFollowsParams struct {
+	ViewerID string
+	UserID   string
+	Limit    int
+
+	// After is the position of the last user on the previous page, nil on
+	// the first page
+	After *pagination.Cursor
+}
+
+// MutualFollow is a user followed by both users along with the time the
+// viewer followed them
+type MutualFollow struct {
+	User       models.User
+	FollowedAt time.Time
+}
+
+func (r *repository) ListMutualFollows(ctx context.Context, params ListMutualFollowsParams) ([]MutualFollow, error) {
+	builder := r.queryBuilder.
+		Select(append(userColumnsOf("u"), "vf.created_at")...).
+		From("users u").
+		Join("followers vf ON vf.followee_id = u.id AND vf.follower_id = ?", params.ViewerID).
+		Join("followers pf ON pf.followee_id = u.id AND pf.follower_id = ?", params.UserID).
+		OrderBy("vf.created_at DESC", "u.id DESC").
+		Limit(uint64(params.Limit))
+
+	if params.After != nil {
+		builder = builder.Where(pagination.Before("vf.created_at", "u.id", *params.After))
+	}
+
+	query, args, _ := builder.ToSql()
+
+	rows, err := r.readerDB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var follows []MutualFollow
+
+	for rows.Next() {
+		var follow MutualFollow
+
+		follow.User, err = scanUser(rows, &follow.FollowedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		follows = append(follows, follow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
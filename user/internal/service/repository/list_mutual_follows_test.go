@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+)
+
+func TestListMutualFollows(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+	prefix := uniquePrefix()
+
+	viewer := createTestUser(t, r, prefix+"viewer", 0)
+	profile := createTestUser(t, r, prefix+"profile", 0)
+
+	users := make(map[string]models.User)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		users[name] = createTestUser(t, r, prefix+name, 0)
+	}
+
+	// The viewer follows a-d an hour apart, the profile follows b-e. The
+	// intersection is b-d, newest follow by the viewer first
+	start := time.Now().Add(-24 * time.Hour).Truncate(time.Millisecond)
+	for i, name := range []string{"a", "b", "c", "d"} {
+		follow(t, db, viewer, users[name], start.Add(time.Duration(i)*time.Hour))
+	}
+	for _, name := range []string{"b", "c", "d", "e"} {
+		follow(t, db, profile, users[name], start)
+	}
+
+	params := ListMutualFollowsParams{ViewerID: viewer.ID, UserID: profile.ID, Limit: 10}
+
+	all, err := r.ListMutualFollows(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := prefix + "d," + prefix + "c," + prefix + "b"
+	if got := mutualScreenNames(all); got != want {
+		t.Fatalf("mutual follows = %s, want %s", got, want)
+	}
+
+	for _, follow := range all {
+		if follow.FollowedAt.IsZero() {
+			t.Errorf("%s has no followed at time", follow.User.ScreenName)
+		}
+	}
+
+	params.Limit = 2
+
+	first, err := r.ListMutualFollows(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := mutualScreenNames(first), prefix+"d,"+prefix+"c"; got != want {
+		t.Fatalf("first page = %s, want %s", got, want)
+	}
+
+	last := first[len(first)-1]
+	params.After = &pagination.Cursor{Time: last.FollowedAt, ID: last.User.ID}
+
+	second, err := r.ListMutualFollows(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := mutualScreenNames(second), prefix+"b"; got != want {
+		t.Errorf("second page = %s, want %s", got, want)
+	}
+}
+
+func TestListMutualFollowsNone(t *testing.T) {
+	r, db := newTestRepository(t)
+	prefix := uniquePrefix()
+
+	viewer := createTestUser(t, r, prefix+"viewer", 0)
+	profile := createTestUser(t, r, prefix+"profile", 0)
+	a := createTestUser(t, r, prefix+"a", 0)
+	b := createTestUser(t, r, prefix+"b", 0)
+
+	follow(t, db, viewer, a, time.Now())
+	follow(t, db, profile, b, time.Now())
+
+	follows, err := r.ListMutualFollows(context.Background(), ListMutualFollowsParams{
+		ViewerID: viewer.ID,
+		UserID:   profile.ID,
+		Limit:    10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(follows) != 0 {
+		t.Errorf("mutual follows = %s, want none", mutualScreenNames(follows))
+	}
+}
+
+func mutualScreenNames(follows []MutualFollow) string {
+	users := make([]models.User, len(follows))
+	for i, follow := range follows {
+		users[i] = follow.User
+	}
+
+	return screenNames(users)
+}
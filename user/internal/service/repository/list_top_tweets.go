@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/Masterminds/squirrel"
+)
+
+type ListTopTweetsParams struct {
+	UserID string
+	// Since and Until are the UTC instants the period starts and ends at
+	Since time.Time
+	Until time.Time
+	Limit int
+
+	// After is the position of the last tweet on the previous page, nil on
+	// the first page
+	After *pagination.Cursor
+}
+
+func (r *repository) ListTopTweets(ctx context.Context, params ListTopTweetsParams) ([]models.TopTweet, error) {
+	// Self-likes and self-replies are left out like in the activity heatmap
+	tweets := squirrel.
+		Select(
+			"t.id",
+			"coalesce(t.content, '') AS content",
+			"t.created_at",
+			"(SELECT count(*) FROM favorites f WHERE f.tweet_id = t.id AND f.user_id <> t.user_id) AS likes",
+			"(SELECT count(*) FROM replies rp JOIN tweets rt ON rt.id = rp.reply_id WHERE rp.tweet_id = t.id AND rt.user_id <> t.user_id) AS replies",
+		).
+		From("tweets t").
+		Where(squirrel.Eq{"t.user_id": params.UserID}).
+		Where(squirrel.GtOrEq{"t.created_at": params.Since.UTC()}).
+		Where(squirrel.Lt{"t.created_at": params.Until.UTC()})
+
+	builder := r.queryBuilder.
+		Select("e.id", "e.content", "e.likes", "e.replies", "e.created_at").
+		FromSelect(tweets, "e").
+		OrderBy("e.likes + e.replies DESC", "e.created_at DESC", "e.id DESC").
+		Limit(uint64(params.Limit))
+
+	if params.After != nil {
+		builder = builder.Where(pagination.BeforeScored("e.likes + e.replies", "e.created_at", "e.id", *params.After))
+	}
+
+	query, args, _ := builder.ToSql()
+
+	rows, err := r.readerDB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var topTweets []models.TopTweet
+
+	for rows.Next() {
+		var tweet models.TopTweet
+
+		err := rows.Scan(
+			&tweet.ID,
+			&tweet.Content,
+			&tweet.Likes,
+			&tweet.Replies,
+			&tweet.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		topTweets = append(topTweets, tweet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return topTweets, nil
+}
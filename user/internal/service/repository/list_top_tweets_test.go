@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+func TestListTopTweets(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+	prefix := uniquePrefix()
+
+	author := createTestUser(t, r, prefix+"author", 0)
+	fans := []models.User{
+		createTestUser(t, r, prefix+"fan1", 0),
+		createTestUser(t, r, prefix+"fan2", 0),
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	// a has two likes, b a like and a reply, c a self-like and a self-reply
+	// that don't count, d is older than the period
+	a := tweet(t, db, author, "a", now.Add(-3*time.Hour))
+	b := tweet(t, db, author, "b", now.Add(-2*time.Hour))
+	c := tweet(t, db, author, "c", now.Add(-time.Hour))
+	d := tweet(t, db, author, "d", now.Add(-48*time.Hour))
+
+	like(t, db, fans[0], a)
+	like(t, db, fans[1], a)
+	like(t, db, fans[0], b)
+	reply(t, db, fans[1], b, now)
+	like(t, db, author, c)
+	reply(t, db, author, c, now)
+	like(t, db, fans[0], d)
+
+	params := ListTopTweetsParams{
+		UserID: author.ID,
+		Since:  now.Add(-24 * time.Hour),
+		Until:  now.Add(time.Hour),
+		Limit:  10,
+	}
+
+	all, err := r.ListTopTweets(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a and b tie on engagement, the newer one comes first
+	if got, want := topTweetContents(all), "b,a,c"; got != want {
+		t.Fatalf("top tweets = %s, want %s", got, want)
+	}
+
+	if all[0].Likes != 1 || all[0].Replies != 1 || all[2].Likes != 0 || all[2].Replies != 0 {
+		t.Errorf("engagement = %+v", all)
+	}
+
+	params.Limit = 1
+	params.After = &pagination.Cursor{Score: int64(all[0].Engagement()), Time: all[0].CreatedAt, ID: all[0].ID}
+
+	next, err := r.ListTopTweets(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := topTweetContents(next), "a"; got != want {
+		t.Errorf("next page = %s, want %s", got, want)
+	}
+}
+
+func tweet(t *testing.T, db *pgxpool.Pool, author models.User, content string, at time.Time) string {
+	t.Helper()
+
+	var id string
+
+	err := db.QueryRow(context.Background(),
+		"INSERT INTO tweets (user_id, content, created_at) VALUES ($1, $2, $3) RETURNING id",
+		author.ID, content, at.UTC()).Scan(&id)
+	if err != nil {
+		t.Fatalf("creating tweet %s: %v", content, err)
+	}
+
+	return id
+}
+
+func like(t *testing.T, db *pgxpool.Pool, user models.User, tweetID string) {
+	t.Helper()
+
+	_, err := db.Exec(context.Background(),
+		"INSERT INTO favorites (user_id, tweet_id) VALUES ($1, $2)", user.ID, tweetID)
+	if err != nil {
+		t.Fatalf("%s liking %s: %v", user.ScreenName, tweetID, err)
+	}
+}
+
+func reply(t *testing.T, db *pgxpool.Pool, user models.User, tweetID string, at time.Time) {
+	t.Helper()
+
+	replyID := tweet(t, db, user, "reply", at)
+
+	_, err := db.Exec(context.Background(),
+		"INSERT INTO replies (tweet_id, reply_id) VALUES ($1, $2)", tweetID, replyID)
+	if err != nil {
+		t.Fatalf("%s replying to %s: %v", user.ScreenName, tweetID, err)
+	}
+}
+
+func topTweetContents(tweets []models.TopTweet) string {
+	contents := make([]string, len(tweets))
+	for i, tweet := range tweets {
+		contents[i] = tweet.Content
+	}
+
+	return strings.Join(contents, ",")
+}
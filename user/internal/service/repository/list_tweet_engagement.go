@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/Masterminds/squirrel"
+)
+
+// listTweetEngagementDaysQuery buckets the likes, replies and retweets of a
+// tweet by day in the given time zone, created_at columns hold UTC
+// wall-clock times. Engagement from the author and likes without a known
+// time are left out like in the activity heatmap
+const listTweetEngagementDaysQuery = `
+SELECT
+	e.day,
+	count(*) FILTER (WHERE e.kind = 'like'),
+	count(*) FILTER (WHERE e.kind = 'reply'),
+	count(*) FILTER (WHERE e.kind = 'retweet')
+FROM (
+	SELECT date_trunc('day', f.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date AS day, 'like' AS kind
+	FROM favorites f
+	JOIN tweets t ON t.id = f.tweet_id
+	WHERE f.tweet_id = $2 AND f.user_id <> t.user_id AND f.created_at >= $3 AND f.created_at < $4
+
+	UNION ALL
+
+	SELECT date_trunc('day', rt.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, 'reply'
+	FROM replies r
+	JOIN tweets t ON t.id = r.tweet_id
+	JOIN tweets rt ON rt.id = r.reply_id
+	WHERE r.tweet_id = $2 AND rt.user_id <> t.user_id AND rt.created_at >= $3 AND rt.created_at < $4
+
+	UNION ALL
+
+	SELECT date_trunc('day', rw.created_at AT TIME ZONE 'UTC' AT TIME ZONE $1)::date, 'retweet'
+	FROM retweets r
+	JOIN tweets t ON t.id = r.tweet_id
+	JOIN tweets rw ON rw.id = r.retweet_id
+	WHERE r.tweet_id = $2 AND rw.user_id <> t.user_id AND rw.created_at >= $3 AND rw.created_at < $4
+) e
+GROUP BY e.day
+ORDER BY e.day`
+
+type ListTweetEngagementDaysParams struct {
+	TweetID  string
+	Timezone string
+	// Since and Until are the UTC instants the period starts and ends at
+	Since time.Time
+	Until time.Time
+}
+
+func (r *repository) FindTweetAuthorID(ctx context.Context, tweetID string) (string, error) {
+	query, args, _ := r.queryBuilder.
+		Select("user_id").
+		From("tweets").
+		Where(squirrel.Eq{"id": tweetID}).
+		ToSql()
+
+	var authorID string
+	err := r.readerDB.QueryRow(ctx, query, args...).Scan(&authorID)
+
+	return authorID, err
+}
+
+func (r *repository) ListTweetEngagementDays(ctx context.Context, params ListTweetEngagementDaysParams) ([]models.TweetEngagementDay, error) {
+	rows, err := r.readerDB.Query(ctx, listTweetEngagementDaysQuery, params.Timezone, params.TweetID, params.Since.UTC(), params.Until.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []models.TweetEngagementDay
+
+	for rows.Next() {
+		var day models.TweetEngagementDay
+
+		if err := rows.Scan(&day.Date, &day.Likes, &day.Replies, &day.Retweets); err != nil {
+			return nil, err
+		}
+
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
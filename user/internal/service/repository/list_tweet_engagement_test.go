@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+func TestListTweetEngagementDays(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+	prefix := uniquePrefix()
+
+	author := createTestUser(t, r, prefix+"author", 0)
+	fans := []models.User{
+		createTestUser(t, r, prefix+"fan1", 0),
+		createTestUser(t, r, prefix+"fan2", 0),
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+
+	// Two likes and a retweet today, a reply yesterday. The author's own
+	// like, reply and retweet don't count, nor does engagement on b
+	a := tweet(t, db, author, "a", yesterday)
+	b := tweet(t, db, author, "b", yesterday)
+
+	like(t, db, fans[0], a)
+	like(t, db, fans[1], a)
+	like(t, db, author, a)
+	reply(t, db, fans[0], a, yesterday.Add(time.Hour))
+	reply(t, db, author, a, yesterday.Add(time.Hour))
+	retweet(t, db, fans[1], a, today.Add(time.Minute))
+	retweet(t, db, author, a, today.Add(time.Minute))
+	like(t, db, fans[0], b)
+
+	authorID, err := r.FindTweetAuthorID(ctx, a)
+	if err != nil || authorID != author.ID {
+		t.Fatalf("FindTweetAuthorID() = %s, %v, want %s", authorID, err, author.ID)
+	}
+
+	days, err := r.ListTweetEngagementDays(ctx, ListTweetEngagementDaysParams{
+		TweetID:  a,
+		Timezone: "UTC",
+		Since:    yesterday,
+		Until:    today.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.TweetEngagementDay{
+		{Date: yesterday, Replies: 1},
+		{Date: today, Likes: 2, Retweets: 1},
+	}
+
+	if len(days) != len(want) {
+		t.Fatalf("days = %+v, want %+v", days, want)
+	}
+
+	for i, day := range days {
+		if !day.Date.Equal(want[i].Date) || day.Likes != want[i].Likes || day.Replies != want[i].Replies || day.Retweets != want[i].Retweets {
+			t.Errorf("day %d = %+v, want %+v", i, day, want[i])
+		}
+	}
+}
+
+func retweet(t *testing.T, db *pgxpool.Pool, user models.User, tweetID string, at time.Time) {
+	t.Helper()
+
+	retweetID := tweet(t, db, user, "", at)
+
+	_, err := db.Exec(context.Background(),
+		"INSERT INTO retweets (tweet_id, retweet_id) VALUES ($1, $2)", tweetID, retweetID)
+	if err != nil {
+		t.Fatalf("%s retweeting %s: %v", user.ScreenName, tweetID, err)
+	}
+}
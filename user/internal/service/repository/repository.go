@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/HotPotatoC/twitter-clone/user/internal/models"
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -13,14 +15,97 @@ type Repository interface {
 	// FindUserByID finds a user by id
 	FindUserByID(ctx context.Context, id string) (models.User, error)
 
+	// FindUserByIDFromWriter finds a user by id on the writer, used to tell
+	// missing users apart from replication lag
+	FindUserByIDFromWriter(ctx context.Context, id string) (models.User, error)
+
 	// FindUserByEmail finds a user by email
 	FindUserByEmail(ctx context.Context, email string) (models.User, error)
 
+	// FindUserByEmailFromWriter finds a user by email on the writer
+	FindUserByEmailFromWriter(ctx context.Context, email string) (models.User, error)
+
+	// FindUsersByIDs finds every existing user out of the given ids
+	FindUsersByIDs(ctx context.Context, ids []string) ([]models.User, error)
+
+	// FindUsersByIDsFromWriter finds every existing user out of the given ids
+	// on the writer
+	FindUsersByIDsFromWriter(ctx context.Context, ids []string) ([]models.User, error)
+
+	// IsFollowing determines whether followerID follows followeeID
+	IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error)
+
+	// ListMutualFollows lists the users followed by both params.ViewerID and
+	// params.UserID, most recently followed by the viewer first
+	ListMutualFollows(ctx context.Context, params ListMutualFollowsParams) ([]MutualFollow, error)
+
+	// AutocompleteConnections lists the users matching params.Prefix that
+	// params.ViewerID follows, or that follow them when followingOnly is
+	// false, followed users first
+	AutocompleteConnections(ctx context.Context, params AutocompleteUsersParams, followingOnly bool) ([]models.User, error)
+
+	// AutocompleteUsers lists any users matching params.Prefix, most followed
+	// first
+	AutocompleteUsers(ctx context.Context, params AutocompleteUsersParams) ([]models.User, error)
+
+	// ListActivityDays counts the tweets, likes and replies received, and new
+	// followers of a user per day since params.Since, days without any
+	// activity are left out
+	ListActivityDays(ctx context.Context, params ListActivityDaysParams) ([]models.ActivityDay, error)
+
+	// ListFollowerDays counts the followers a user gained per day between
+	// params.Since and params.Until, days without new followers are left out
+	ListFollowerDays(ctx context.Context, params ListFollowerDaysParams) ([]FollowerDay, error)
+
+	// CountFollowersBefore counts the current followers of a user who
+	// followed them before the given time
+	CountFollowersBefore(ctx context.Context, userID string, before time.Time) (int, error)
+
+	// ListTopTweets lists the tweets a user posted between params.Since and
+	// params.Until, most likes and replies from other users first
+	ListTopTweets(ctx context.Context, params ListTopTweetsParams) ([]models.TopTweet, error)
+
+	// FindTweetAuthorID finds the id of the user who posted a tweet
+	FindTweetAuthorID(ctx context.Context, tweetID string) (string, error)
+
+	// ListTweetEngagementDays counts the likes, replies and retweets a tweet
+	// got from other users per day between params.Since and params.Until,
+	// days without any are left out
+	ListTweetEngagementDays(ctx context.Context, params ListTweetEngagementDaysParams) ([]models.TweetEngagementDay, error)
+
+	// ListDailyStats counts the signups and tweets of every user per day
+	// between params.Since and params.Until, days without either are left out
+	ListDailyStats(ctx context.Context, params ListDailyStatsParams) ([]models.DailyStat, error)
+
 	// CreateUser creates a new user
 	CreateUser(ctx context.Context, params models.User) (models.User, error)
 
+	// CreateUserWithInvite creates a new user redeeming the given invite code
+	// in the same transaction, returns pgx.ErrNoRows if the code is unknown,
+	// used up or expired
+	CreateUserWithInvite(ctx context.Context, params models.User, code string) (models.User, error)
+
 	// DeleteUser deletes an existing user
 	DeleteUser(ctx context.Context, id string) error
+
+	// CreateInviteCode creates a new invite code
+	CreateInviteCode(ctx context.Context, params models.InviteCode) (models.InviteCode, error)
+
+	// FindInviteCode finds an invite code
+	FindInviteCode(ctx context.Context, code string) (models.InviteCode, error)
+
+	// ListInviteCodes lists invite codes, newest first
+	ListInviteCodes(ctx context.Context, params ListInviteCodesParams) ([]models.InviteCode, error)
+
+	// CountInviteCodesSince counts the invite codes generated by inviterID
+	// since the given time
+	CountInviteCodesSince(ctx context.Context, inviterID string, since time.Time) (int, error)
+}
+
+// querier is implemented by both the connection pool and transactions
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
 type repository struct {
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestTweetsCountTrigger checks the trigger from init.sql keeping
+// users.tweets_count in sync with the tweets table
+func TestTweetsCountTrigger(t *testing.T) {
+	r, db := newTestRepository(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, r, uniquePrefix(), 0)
+
+	assertTweetsCount := func(want int) {
+		t.Helper()
+
+		found, err := r.FindUserByIDFromWriter(ctx, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found.TweetsCount != want {
+			t.Errorf("tweets_count = %d, want %d", found.TweetsCount, want)
+		}
+	}
+
+	assertTweetsCount(0)
+
+	var tweetIDs []string
+
+	for i := 0; i < 2; i++ {
+		var id string
+
+		err := db.QueryRow(ctx,
+			"INSERT INTO tweets (user_id, content, created_at) VALUES ($1, $2, $3) RETURNING id",
+			user.ID, "hello", time.Now().UTC()).Scan(&id)
+		if err != nil {
+			t.Fatalf("creating tweet: %v", err)
+		}
+
+		tweetIDs = append(tweetIDs, id)
+	}
+
+	assertTweetsCount(2)
+
+	if _, err := db.Exec(ctx, "DELETE FROM tweets WHERE id = $1", tweetIDs[0]); err != nil {
+		t.Fatalf("deleting tweet: %v", err)
+	}
+
+	assertTweetsCount(1)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+)
+
+// userColumns are the users columns read by scanUser, in scan order
+var userColumns = []string{
+	"id",
+	"name",
+	"screen_name",
+	"password_hash",
+	"email",
+	"bio",
+	"location",
+	"website",
+	"birth_date",
+	"profile_image_url",
+	"profile_banner_url",
+	"followers_count",
+	"followings_count",
+	"created_at",
+	"updated_at",
+	"tweets_count",
+	"timezone",
+}
+
+// userColumnsOf returns userColumns qualified with a table alias
+func userColumnsOf(alias string) []string {
+	columns := make([]string, len(userColumns))
+	for i, column := range userColumns {
+		columns[i] = alias + "." + column
+	}
+
+	return columns
+}
+
+// rowScanner is implemented by both pgx.Row and pgx.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanUser scans a row selecting userColumns, extra receives the columns
+// selected after them
+func scanUser(row rowScanner, extra ...any) (models.User, error) {
+	var user models.User
+
+	dest := []any{
+		&user.ID,
+		&user.Name,
+		&user.ScreenName,
+		&user.PasswordHash,
+		&user.Email,
+		&user.Bio,
+		&user.Location,
+		&user.Website,
+		&user.BirthDate,
+		&user.ProfileImageURL,
+		&user.ProfileBannerURL,
+		&user.FollowersCount,
+		&user.FollowingsCount,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.TweetsCount,
+		&user.Timezone,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return models.User{}, err
+	}
+
+	return user, nil
+}
+
+// returningUserColumns is the RETURNING clause of statements that return the
+// affected users
+func returningUserColumns() string {
+	return "RETURNING " + strings.Join(userColumns, ", ")
+}
+
+// queryUsers runs a query selecting userColumns
+func queryUsers(ctx context.Context, db querier, query string, args ...any) ([]models.User, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []models.User
+
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
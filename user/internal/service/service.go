@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/HotPotatoC/twitter-clone/user/clients"
+	"github.com/HotPotatoC/twitter-clone/user/config"
 	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
 	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
 )
 
@@ -16,22 +19,97 @@ type Service interface {
 	// FindUserByEmail finds a user by email
 	FindUserByEmail(ctx context.Context, email string) (models.User, error)
 
-	// CreateUser creates a new user
+	// FindUsersByIDs finds multiple users by id, keyed by id as given.
+	// Missing users are left out of the result and found users are cached
+	// for CACHE_USER_TTL
+	FindUsersByIDs(ctx context.Context, ids []string) (map[string]models.User, error)
+
+	// IsFollowing determines whether followerID follows followeeID
+	IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error)
+
+	// ListMutualFollows lists the users followed by both params.ViewerID and
+	// params.UserID
+	ListMutualFollows(ctx context.Context, params ListMutualFollowsParams) (pagination.Page[models.User], error)
+
+	// AutocompleteUsers suggests users matching the start of params.Query,
+	// people the viewer follows first, then their followers, then everyone
+	// else by follower count
+	AutocompleteUsers(ctx context.Context, params AutocompleteUsersParams) ([]models.User, error)
+
+	// ListActivityDays returns the daily activity of a user over the last
+	// weeks, one entry per day in the user's time zone, oldest first
+	ListActivityDays(ctx context.Context, userID string, weeks int) ([]models.ActivityDay, error)
+
+	// ListFollowerGrowth returns the followers a user gained over
+	// params.Period by params.Interval, oldest first in the user's time zone.
+	// Returns ErrInvalidPeriod if the period is out of bounds
+	ListFollowerGrowth(ctx context.Context, params ListFollowerGrowthParams) ([]models.FollowerGrowthBucket, error)
+
+	// ListTopTweets lists the tweets a user posted over params.Period, most
+	// likes and replies from other users first. Returns ErrInvalidPeriod if
+	// the period is out of bounds
+	ListTopTweets(ctx context.Context, params ListTopTweetsParams) (pagination.Page[models.TopTweet], error)
+
+	// ListTweetEngagement returns the likes, replies and retweets a tweet got
+	// from other users over params.Period, one entry per day in the author's
+	// time zone, oldest first. Returns ErrNotAuthor if params.RequesterID
+	// didn't post the tweet and ErrInvalidPeriod if the period is out of
+	// bounds
+	ListTweetEngagement(ctx context.Context, params ListTweetEngagementParams) ([]models.TweetEngagementDay, error)
+
+	// CreateUser creates a new user, returns ErrEmailTaken if the email
+	// belongs to an existing user
 	CreateUser(ctx context.Context, params CreateUserParams) (models.User, error)
 
+	// GenerateInvite generates an invite code for params.InviterID
+	GenerateInvite(ctx context.Context, params GenerateInviteParams) (models.InviteCode, error)
+
+	// CheckInvite checks whether signing up with the invite token would be
+	// allowed, returns ErrRegistrationClosed or ErrInvalidInvite otherwise.
+	// Any token is allowed while registration is open
+	CheckInvite(ctx context.Context, inviteToken string) error
+
+	// ListInvites lists invite codes for admins, newest first
+	ListInvites(ctx context.Context, params ListInvitesParams) (pagination.Page[models.InviteCode], error)
+
+	// ListDailyStats returns the signups and tweets of every user over
+	// params.Period for admins, one entry per day in params.Timezone, oldest
+	// first. Returns ErrAdminRequired for anyone else and ErrInvalidPeriod if
+	// the period is out of bounds
+	ListDailyStats(ctx context.Context, params ListDailyStatsParams) ([]models.DailyStat, error)
+
+	// RegistrationOpen reports whether anyone can sign up without an invite
+	RegistrationOpen() bool
+
 	// DeleteUser deletes an existing user
 	DeleteUser(ctx context.Context, id string) error
 }
 
 type service struct {
-	clients    clients.Clients
-	repository repository.Repository
+	clients      clients.Clients
+	repository   repository.Repository
+	notFound     *ttlCache[struct{}]
+	users        *ttlCache[models.User]
+	autocomplete *ttlCache[[]models.User]
+	activity     *ttlCache[[]models.ActivityDay]
+	stats        *ttlCache[[]models.DailyStat]
+	cursors      *pagination.Codec
+	registration config.RegistrationConfig
+	publicURL    string
 }
 
 // NewService creates a new user business-layer service
-func NewService(clients clients.Clients) Service {
+func NewService(cfg *config.Config, clients clients.Clients) Service {
 	return &service{
-		clients:    clients,
-		repository: repository.NewRepository(clients.WriterDB, clients.ReaderDB),
+		clients:      clients,
+		repository:   repository.NewRepository(clients.WriterDB, clients.ReaderDB),
+		notFound:     newTTLCache[struct{}](cfg.Cache.NotFoundTTL),
+		users:        newTTLCache[models.User](cfg.Cache.UserTTL),
+		autocomplete: newTTLCache[[]models.User](cfg.Cache.AutocompleteTTL),
+		activity:     newTTLCache[[]models.ActivityDay](cfg.Cache.ActivityTTL),
+		stats:        newTTLCache[[]models.DailyStat](cfg.Cache.StatsTTL),
+		cursors:      pagination.NewCodec([]byte(cfg.App.CursorSecret)),
+		registration: cfg.Registration,
+		publicURL:    strings.TrimSuffix(cfg.App.PublicURL, "/"),
 	}
 }
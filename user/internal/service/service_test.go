@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/HotPotatoC/twitter-clone/user/config"
+	"github.com/HotPotatoC/twitter-clone/user/internal/models"
+	"github.com/HotPotatoC/twitter-clone/user/internal/pagination"
+	"github.com/HotPotatoC/twitter-clone/user/internal/service/repository"
+)
+
+// fakeRepository stubs the repository methods a test needs, calling any other
+// method panics on the nil embedded interface
+type fakeRepository struct {
+	repository.Repository
+
+	autocompleteConnections   func(params repository.AutocompleteUsersParams, followingOnly bool) ([]models.User, error)
+	autocompleteUsers         func(params repository.AutocompleteUsersParams) ([]models.User, error)
+	countFollowersBefore      func(userID string, before time.Time) (int, error)
+	countInviteCodesSince     func(inviterID string, since time.Time) (int, error)
+	createInviteCode          func(invite models.InviteCode) (models.InviteCode, error)
+	createUser                func(user models.User) (models.User, error)
+	createUserWithInvite      func(user models.User, code string) (models.User, error)
+	findInviteCode            func(code string) (models.InviteCode, error)
+	findTweetAuthorID         func(tweetID string) (string, error)
+	findUserByEmailFromWriter func(email string) (models.User, error)
+	findUserByID              func(id string) (models.User, error)
+	findUserByIDFromWriter    func(id string) (models.User, error)
+	findUsersByIDs            func(ids []string) ([]models.User, error)
+	findUsersByIDsFromWriter  func(ids []string) ([]models.User, error)
+	listActivityDays          func(params repository.ListActivityDaysParams) ([]models.ActivityDay, error)
+	listDailyStats            func(params repository.ListDailyStatsParams) ([]models.DailyStat, error)
+	listFollowerDays          func(params repository.ListFollowerDaysParams) ([]repository.FollowerDay, error)
+	listTweetEngagementDays   func(params repository.ListTweetEngagementDaysParams) ([]models.TweetEngagementDay, error)
+}
+
+func (f *fakeRepository) AutocompleteConnections(ctx context.Context, params repository.AutocompleteUsersParams, followingOnly bool) ([]models.User, error) {
+	return f.autocompleteConnections(params, followingOnly)
+}
+
+func (f *fakeRepository) AutocompleteUsers(ctx context.Context, params repository.AutocompleteUsersParams) ([]models.User, error) {
+	return f.autocompleteUsers(params)
+}
+
+func (f *fakeRepository) CountFollowersBefore(ctx context.Context, userID string, before time.Time) (int, error) {
+	return f.countFollowersBefore(userID, before)
+}
+
+func (f *fakeRepository) CountInviteCodesSince(ctx context.Context, inviterID string, since time.Time) (int, error) {
+	return f.countInviteCodesSince(inviterID, since)
+}
+
+func (f *fakeRepository) CreateInviteCode(ctx context.Context, params models.InviteCode) (models.InviteCode, error) {
+	return f.createInviteCode(params)
+}
+
+func (f *fakeRepository) CreateUser(ctx context.Context, params models.User) (models.User, error) {
+	return f.createUser(params)
+}
+
+func (f *fakeRepository) CreateUserWithInvite(ctx context.Context, params models.User, code string) (models.User, error) {
+	return f.createUserWithInvite(params, code)
+}
+
+func (f *fakeRepository) FindInviteCode(ctx context.Context, code string) (models.InviteCode, error) {
+	return f.findInviteCode(code)
+}
+
+func (f *fakeRepository) FindTweetAuthorID(ctx context.Context, tweetID string) (string, error) {
+	return f.findTweetAuthorID(tweetID)
+}
+
+func (f *fakeRepository) FindUserByEmailFromWriter(ctx context.Context, email string) (models.User, error) {
+	return f.findUserByEmailFromWriter(email)
+}
+
+func (f *fakeRepository) FindUserByID(ctx context.Context, id string) (models.User, error) {
+	return f.findUserByID(id)
+}
+
+func (f *fakeRepository) FindUserByIDFromWriter(ctx context.Context, id string) (models.User, error) {
+	return f.findUserByIDFromWriter(id)
+}
+
+func (f *fakeRepository) FindUsersByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	return f.findUsersByIDs(ids)
+}
+
+func (f *fakeRepository) FindUsersByIDsFromWriter(ctx context.Context, ids []string) ([]models.User, error) {
+	return f.findUsersByIDsFromWriter(ids)
+}
+
+func (f *fakeRepository) ListActivityDays(ctx context.Context, params repository.ListActivityDaysParams) ([]models.ActivityDay, error) {
+	return f.listActivityDays(params)
+}
+
+func (f *fakeRepository) ListDailyStats(ctx context.Context, params repository.ListDailyStatsParams) ([]models.DailyStat, error) {
+	return f.listDailyStats(params)
+}
+
+func (f *fakeRepository) ListFollowerDays(ctx context.Context, params repository.ListFollowerDaysParams) ([]repository.FollowerDay, error) {
+	return f.listFollowerDays(params)
+}
+
+func (f *fakeRepository) ListTweetEngagementDays(ctx context.Context, params repository.ListTweetEngagementDaysParams) ([]models.TweetEngagementDay, error) {
+	return f.listTweetEngagementDays(params)
+}
+
+// newTestService creates a service on top of repo with every cache enabled
+func newTestService(t *testing.T, repo repository.Repository) *service {
+	t.Helper()
+
+	return &service{
+		repository:   repo,
+		notFound:     newTTLCache[struct{}](time.Minute),
+		users:        newTTLCache[models.User](time.Minute),
+		autocomplete: newTTLCache[[]models.User](time.Minute),
+		activity:     newTTLCache[[]models.ActivityDay](time.Minute),
+		stats:        newTTLCache[[]models.DailyStat](time.Minute),
+		cursors:      pagination.NewCodec([]byte("test-secret")),
+		registration: config.RegistrationConfig{
+			Open:             true,
+			InvitesPerDay:    5,
+			InviteTTL:        24 * time.Hour,
+			MaxInviteUses:    1,
+			AnyUserCanInvite: true,
+		},
+		publicURL: "http://localhost:7000",
+	}
+}
+
+func userIDs(users []models.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+
+	return ids
+}
@@ -8,6 +8,10 @@ import (
 
 var M *zap.SugaredLogger
 
+// Startup logs what the service starts with, e.g. its config, at info level
+// even in production where M only logs warnings and errors
+var Startup *zap.SugaredLogger
+
 func Init(debug bool) {
 	var consoleEncoder, jsonEncoder zapcore.EncoderConfig
 
@@ -28,12 +32,20 @@ func Init(debug bool) {
 
 	consoleEncoder.EncodeLevel = nil
 
+	stdout := zapcore.AddSync(colorable.NewColorableStdout())
+
 	core := zapcore.NewCore(
 		zapcore.NewConsoleEncoder(consoleEncoder),
-		zapcore.AddSync(colorable.NewColorableStdout()),
+		stdout,
 		level,
 	)
 
+	Startup = zap.New(zapcore.NewCore(
+		zapcore.NewConsoleEncoder(consoleEncoder),
+		stdout,
+		zap.InfoLevel,
+	)).Sugar()
+
 	logger := zap.New(core, zap.WithClock(zapcore.DefaultClock))
 	defer logger.Sync()
 
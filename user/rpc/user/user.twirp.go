@@ -39,11 +39,53 @@ type UserService interface {
 	// FindUserByEmail finds a user by email
 	FindUserByEmail(context.Context, *FindUserByEmailRequest) (*FindUserByEmailResponse, error)
 
+	// FindUserSummariesByIDs finds the summaries of multiple users at once
+	FindUserSummariesByIDs(context.Context, *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error)
+
+	// ListMutualFollows lists the users followed by both the viewer and another user
+	ListMutualFollows(context.Context, *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error)
+
+	// AutocompleteUsers suggests users to mention while composing a tweet
+	AutocompleteUsers(context.Context, *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error)
+
+	// ListActivityDays lists the daily activity of a user for their dashboard
+	ListActivityDays(context.Context, *ListActivityDaysRequest) (*ListActivityDaysResponse, error)
+
+	// ListFollowerGrowth lists the followers gained by a user over time for
+	// their analytics
+	ListFollowerGrowth(context.Context, *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error)
+
+	// ListTopTweets lists the tweets of a user with the most engagement for
+	// their analytics. Engagement is likes and replies, impressions are left
+	// out until tweet views are tracked
+	ListTopTweets(context.Context, *ListTopTweetsRequest) (*ListTopTweetsResponse, error)
+
+	// ListTweetEngagement lists the likes, replies and retweets a tweet got
+	// per day for its author
+	ListTweetEngagement(context.Context, *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error)
+
 	// CreateUser creates a new user
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 
 	// DeleteUser deletes an existing user
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+
+	// GenerateInvite generates an invite code with a usage limit and expiry
+	GenerateInvite(context.Context, *GenerateInviteRequest) (*GenerateInviteResponse, error)
+
+	// CheckInvite checks whether signing up with an invite token would be
+	// allowed without consuming it
+	CheckInvite(context.Context, *CheckInviteRequest) (*CheckInviteResponse, error)
+
+	// ListInvites lists invite codes along with how many times they were redeemed
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+
+	// ListDailyStats lists the signups and tweets of the whole service per day
+	// for admins
+	ListDailyStats(context.Context, *ListDailyStatsRequest) (*ListDailyStatsResponse, error)
+
+	// GetConfig returns the public settings of the service
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
 }
 
 // ===========================
@@ -52,7 +94,7 @@ type UserService interface {
 
 type userServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [4]string
+	urls        [16]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -80,11 +122,23 @@ func NewUserServiceProtobufClient(baseURL string, client HTTPClient, opts ...twi
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "hotpotatoc.twitter_clone.user", "UserService")
-	urls := [4]string{
+	urls := [16]string{
 		serviceURL + "FindUserByID",
 		serviceURL + "FindUserByEmail",
+		serviceURL + "FindUserSummariesByIDs",
+		serviceURL + "ListMutualFollows",
+		serviceURL + "AutocompleteUsers",
+		serviceURL + "ListActivityDays",
+		serviceURL + "ListFollowerGrowth",
+		serviceURL + "ListTopTweets",
+		serviceURL + "ListTweetEngagement",
 		serviceURL + "CreateUser",
 		serviceURL + "DeleteUser",
+		serviceURL + "GenerateInvite",
+		serviceURL + "CheckInvite",
+		serviceURL + "ListInvites",
+		serviceURL + "ListDailyStats",
+		serviceURL + "GetConfig",
 	}
 
 	return &userServiceProtobufClient{
@@ -187,26 +241,26 @@ func (c *userServiceProtobufClient) callFindUserByEmail(ctx context.Context, in
 	return out, nil
 }
 
-func (c *userServiceProtobufClient) CreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
+func (c *userServiceProtobufClient) FindUserSummariesByIDs(ctx context.Context, in *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
 	ctx = ctxsetters.WithServiceName(ctx, "UserService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
-	caller := c.callCreateUser
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserSummariesByIDs")
+	caller := c.callFindUserSummariesByIDs
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+		caller = func(ctx context.Context, req *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateUserRequest)
+					typedReq, ok := req.(*FindUserSummariesByIDsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateUserRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserSummariesByIDsRequest) when calling interceptor")
 					}
-					return c.callCreateUser(ctx, typedReq)
+					return c.callFindUserSummariesByIDs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateUserResponse)
+				typedResp, ok := resp.(*FindUserSummariesByIDsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateUserResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserSummariesByIDsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -216,8 +270,8 @@ func (c *userServiceProtobufClient) CreateUser(ctx context.Context, in *CreateUs
 	return caller(ctx, in)
 }
 
-func (c *userServiceProtobufClient) callCreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
+func (c *userServiceProtobufClient) callFindUserSummariesByIDs(ctx context.Context, in *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
+	out := new(FindUserSummariesByIDsResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -233,26 +287,26 @@ func (c *userServiceProtobufClient) callCreateUser(ctx context.Context, in *Crea
 	return out, nil
 }
 
-func (c *userServiceProtobufClient) DeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
+func (c *userServiceProtobufClient) ListMutualFollows(ctx context.Context, in *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
 	ctx = ctxsetters.WithServiceName(ctx, "UserService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
-	caller := c.callDeleteUser
+	ctx = ctxsetters.WithMethodName(ctx, "ListMutualFollows")
+	caller := c.callListMutualFollows
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+		caller = func(ctx context.Context, req *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteUserRequest)
+					typedReq, ok := req.(*ListMutualFollowsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteUserRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListMutualFollowsRequest) when calling interceptor")
 					}
-					return c.callDeleteUser(ctx, typedReq)
+					return c.callListMutualFollows(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteUserResponse)
+				typedResp, ok := resp.(*ListMutualFollowsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteUserResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMutualFollowsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -262,8 +316,8 @@ func (c *userServiceProtobufClient) DeleteUser(ctx context.Context, in *DeleteUs
 	return caller(ctx, in)
 }
 
-func (c *userServiceProtobufClient) callDeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
-	out := new(DeleteUserResponse)
+func (c *userServiceProtobufClient) callListMutualFollows(ctx context.Context, in *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
+	out := new(ListMutualFollowsResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -279,75 +333,164 @@ func (c *userServiceProtobufClient) callDeleteUser(ctx context.Context, in *Dele
 	return out, nil
 }
 
-// =======================
-// UserService JSON Client
-// =======================
-
-type userServiceJSONClient struct {
-	client      HTTPClient
-	urls        [4]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *userServiceProtobufClient) AutocompleteUsers(ctx context.Context, in *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "AutocompleteUsers")
+	caller := c.callAutocompleteUsers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AutocompleteUsersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AutocompleteUsersRequest) when calling interceptor")
+					}
+					return c.callAutocompleteUsers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AutocompleteUsersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AutocompleteUsersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewUserServiceJSONClient creates a JSON client that implements the UserService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewUserServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) UserService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *userServiceProtobufClient) callAutocompleteUsers(ctx context.Context, in *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+	out := new(AutocompleteUsersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceProtobufClient) ListActivityDays(ctx context.Context, in *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListActivityDays")
+	caller := c.callListActivityDays
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListActivityDaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListActivityDaysRequest) when calling interceptor")
+					}
+					return c.callListActivityDays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListActivityDaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListActivityDaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *userServiceProtobufClient) callListActivityDays(ctx context.Context, in *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+	out := new(ListActivityDaysResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "hotpotatoc.twitter_clone.user", "UserService")
-	urls := [4]string{
-		serviceURL + "FindUserByID",
-		serviceURL + "FindUserByEmail",
-		serviceURL + "CreateUser",
-		serviceURL + "DeleteUser",
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceProtobufClient) ListFollowerGrowth(ctx context.Context, in *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListFollowerGrowth")
+	caller := c.callListFollowerGrowth
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListFollowerGrowthRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListFollowerGrowthRequest) when calling interceptor")
+					}
+					return c.callListFollowerGrowth(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListFollowerGrowthResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListFollowerGrowthResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &userServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (c *userServiceProtobufClient) callListFollowerGrowth(ctx context.Context, in *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+	out := new(ListFollowerGrowthResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-func (c *userServiceJSONClient) FindUserByID(ctx context.Context, in *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+func (c *userServiceProtobufClient) ListTopTweets(ctx context.Context, in *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
 	ctx = ctxsetters.WithServiceName(ctx, "UserService")
-	ctx = ctxsetters.WithMethodName(ctx, "FindUserByID")
-	caller := c.callFindUserByID
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopTweets")
+	caller := c.callListTopTweets
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+		caller = func(ctx context.Context, req *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindUserByIDRequest)
+					typedReq, ok := req.(*ListTopTweetsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindUserByIDRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopTweetsRequest) when calling interceptor")
 					}
-					return c.callFindUserByID(ctx, typedReq)
+					return c.callListTopTweets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindUserByIDResponse)
+				typedResp, ok := resp.(*ListTopTweetsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByIDResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopTweetsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -357,9 +500,9 @@ func (c *userServiceJSONClient) FindUserByID(ctx context.Context, in *FindUserBy
 	return caller(ctx, in)
 }
 
-func (c *userServiceJSONClient) callFindUserByID(ctx context.Context, in *FindUserByIDRequest) (*FindUserByIDResponse, error) {
-	out := new(FindUserByIDResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *userServiceProtobufClient) callListTopTweets(ctx context.Context, in *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
+	out := new(ListTopTweetsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -374,26 +517,26 @@ func (c *userServiceJSONClient) callFindUserByID(ctx context.Context, in *FindUs
 	return out, nil
 }
 
-func (c *userServiceJSONClient) FindUserByEmail(ctx context.Context, in *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+func (c *userServiceProtobufClient) ListTweetEngagement(ctx context.Context, in *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
 	ctx = ctxsetters.WithServiceName(ctx, "UserService")
-	ctx = ctxsetters.WithMethodName(ctx, "FindUserByEmail")
-	caller := c.callFindUserByEmail
+	ctx = ctxsetters.WithMethodName(ctx, "ListTweetEngagement")
+	caller := c.callListTweetEngagement
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+		caller = func(ctx context.Context, req *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindUserByEmailRequest)
+					typedReq, ok := req.(*ListTweetEngagementRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindUserByEmailRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListTweetEngagementRequest) when calling interceptor")
 					}
-					return c.callFindUserByEmail(ctx, typedReq)
+					return c.callListTweetEngagement(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindUserByEmailResponse)
+				typedResp, ok := resp.(*ListTweetEngagementResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByEmailResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTweetEngagementResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -403,9 +546,9 @@ func (c *userServiceJSONClient) FindUserByEmail(ctx context.Context, in *FindUse
 	return caller(ctx, in)
 }
 
-func (c *userServiceJSONClient) callFindUserByEmail(ctx context.Context, in *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
-	out := new(FindUserByEmailResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *userServiceProtobufClient) callListTweetEngagement(ctx context.Context, in *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
+	out := new(ListTweetEngagementResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -420,7 +563,7 @@ func (c *userServiceJSONClient) callFindUserByEmail(ctx context.Context, in *Fin
 	return out, nil
 }
 
-func (c *userServiceJSONClient) CreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
+func (c *userServiceProtobufClient) CreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
 	ctx = ctxsetters.WithServiceName(ctx, "UserService")
 	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
@@ -449,9 +592,9 @@ func (c *userServiceJSONClient) CreateUser(ctx context.Context, in *CreateUserRe
 	return caller(ctx, in)
 }
 
-func (c *userServiceJSONClient) callCreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
+func (c *userServiceProtobufClient) callCreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
 	out := new(CreateUserResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -466,7 +609,7 @@ func (c *userServiceJSONClient) callCreateUser(ctx context.Context, in *CreateUs
 	return out, nil
 }
 
-func (c *userServiceJSONClient) DeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
+func (c *userServiceProtobufClient) DeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
 	ctx = ctxsetters.WithServiceName(ctx, "UserService")
 	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
@@ -495,9 +638,9 @@ func (c *userServiceJSONClient) DeleteUser(ctx context.Context, in *DeleteUserRe
 	return caller(ctx, in)
 }
 
-func (c *userServiceJSONClient) callDeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
+func (c *userServiceProtobufClient) callDeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
 	out := new(DeleteUserResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -512,123 +655,3346 @@ func (c *userServiceJSONClient) callDeleteUser(ctx context.Context, in *DeleteUs
 	return out, nil
 }
 
-// ==========================
-// UserService Server Handler
-// ==========================
-
-type userServiceServer struct {
-	UserService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *userServiceProtobufClient) GenerateInvite(ctx context.Context, in *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "GenerateInvite")
+	caller := c.callGenerateInvite
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GenerateInviteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GenerateInviteRequest) when calling interceptor")
+					}
+					return c.callGenerateInvite(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GenerateInviteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GenerateInviteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewUserServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewUserServiceServer(svc UserService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *userServiceProtobufClient) callGenerateInvite(ctx context.Context, in *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+	out := new(GenerateInviteResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &userServiceServer{
-		UserService:      svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *userServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *userServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+func (c *userServiceProtobufClient) CheckInvite(ctx context.Context, in *CheckInviteRequest) (*CheckInviteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckInvite")
+	caller := c.callCheckInvite
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckInviteRequest) (*CheckInviteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckInviteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckInviteRequest) when calling interceptor")
+					}
+					return c.callCheckInvite(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckInviteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckInviteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceProtobufClient) callCheckInvite(ctx context.Context, in *CheckInviteRequest) (*CheckInviteResponse, error) {
+	out := new(CheckInviteResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceProtobufClient) ListInvites(ctx context.Context, in *ListInvitesRequest) (*ListInvitesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvites")
+	caller := c.callListInvites
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListInvitesRequest) (*ListInvitesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListInvitesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvitesRequest) when calling interceptor")
+					}
+					return c.callListInvites(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListInvitesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvitesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceProtobufClient) callListInvites(ctx context.Context, in *ListInvitesRequest) (*ListInvitesResponse, error) {
+	out := new(ListInvitesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceProtobufClient) ListDailyStats(ctx context.Context, in *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDailyStats")
+	caller := c.callListDailyStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDailyStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDailyStatsRequest) when calling interceptor")
+					}
+					return c.callListDailyStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDailyStatsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDailyStatsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceProtobufClient) callListDailyStats(ctx context.Context, in *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
+	out := new(ListDailyStatsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceProtobufClient) GetConfig(ctx context.Context, in *GetConfigRequest) (*GetConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
+	caller := c.callGetConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetConfigRequest) when calling interceptor")
+					}
+					return c.callGetConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceProtobufClient) callGetConfig(ctx context.Context, in *GetConfigRequest) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// UserService JSON Client
+// =======================
+
+type userServiceJSONClient struct {
+	client      HTTPClient
+	urls        [16]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewUserServiceJSONClient creates a JSON client that implements the UserService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewUserServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) UserService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "hotpotatoc.twitter_clone.user", "UserService")
+	urls := [16]string{
+		serviceURL + "FindUserByID",
+		serviceURL + "FindUserByEmail",
+		serviceURL + "FindUserSummariesByIDs",
+		serviceURL + "ListMutualFollows",
+		serviceURL + "AutocompleteUsers",
+		serviceURL + "ListActivityDays",
+		serviceURL + "ListFollowerGrowth",
+		serviceURL + "ListTopTweets",
+		serviceURL + "ListTweetEngagement",
+		serviceURL + "CreateUser",
+		serviceURL + "DeleteUser",
+		serviceURL + "GenerateInvite",
+		serviceURL + "CheckInvite",
+		serviceURL + "ListInvites",
+		serviceURL + "ListDailyStats",
+		serviceURL + "GetConfig",
+	}
+
+	return &userServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *userServiceJSONClient) FindUserByID(ctx context.Context, in *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserByID")
+	caller := c.callFindUserByID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserByIDRequest) when calling interceptor")
+					}
+					return c.callFindUserByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callFindUserByID(ctx context.Context, in *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+	out := new(FindUserByIDResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) FindUserByEmail(ctx context.Context, in *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserByEmail")
+	caller := c.callFindUserByEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserByEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserByEmailRequest) when calling interceptor")
+					}
+					return c.callFindUserByEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserByEmailResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByEmailResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callFindUserByEmail(ctx context.Context, in *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+	out := new(FindUserByEmailResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) FindUserSummariesByIDs(ctx context.Context, in *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserSummariesByIDs")
+	caller := c.callFindUserSummariesByIDs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserSummariesByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserSummariesByIDsRequest) when calling interceptor")
+					}
+					return c.callFindUserSummariesByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserSummariesByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserSummariesByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callFindUserSummariesByIDs(ctx context.Context, in *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
+	out := new(FindUserSummariesByIDsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListMutualFollows(ctx context.Context, in *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListMutualFollows")
+	caller := c.callListMutualFollows
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListMutualFollowsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListMutualFollowsRequest) when calling interceptor")
+					}
+					return c.callListMutualFollows(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListMutualFollowsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMutualFollowsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListMutualFollows(ctx context.Context, in *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
+	out := new(ListMutualFollowsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) AutocompleteUsers(ctx context.Context, in *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "AutocompleteUsers")
+	caller := c.callAutocompleteUsers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AutocompleteUsersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AutocompleteUsersRequest) when calling interceptor")
+					}
+					return c.callAutocompleteUsers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AutocompleteUsersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AutocompleteUsersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callAutocompleteUsers(ctx context.Context, in *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+	out := new(AutocompleteUsersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListActivityDays(ctx context.Context, in *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListActivityDays")
+	caller := c.callListActivityDays
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListActivityDaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListActivityDaysRequest) when calling interceptor")
+					}
+					return c.callListActivityDays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListActivityDaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListActivityDaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListActivityDays(ctx context.Context, in *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+	out := new(ListActivityDaysResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListFollowerGrowth(ctx context.Context, in *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListFollowerGrowth")
+	caller := c.callListFollowerGrowth
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListFollowerGrowthRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListFollowerGrowthRequest) when calling interceptor")
+					}
+					return c.callListFollowerGrowth(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListFollowerGrowthResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListFollowerGrowthResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListFollowerGrowth(ctx context.Context, in *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+	out := new(ListFollowerGrowthResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListTopTweets(ctx context.Context, in *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopTweets")
+	caller := c.callListTopTweets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopTweetsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopTweetsRequest) when calling interceptor")
+					}
+					return c.callListTopTweets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopTweetsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopTweetsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListTopTweets(ctx context.Context, in *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
+	out := new(ListTopTweetsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListTweetEngagement(ctx context.Context, in *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTweetEngagement")
+	caller := c.callListTweetEngagement
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTweetEngagementRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTweetEngagementRequest) when calling interceptor")
+					}
+					return c.callListTweetEngagement(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTweetEngagementResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTweetEngagementResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListTweetEngagement(ctx context.Context, in *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
+	out := new(ListTweetEngagementResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) CreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
+	caller := c.callCreateUser
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateUserRequest) when calling interceptor")
+					}
+					return c.callCreateUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callCreateUser(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) DeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
+	caller := c.callDeleteUser
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteUserRequest) when calling interceptor")
+					}
+					return c.callDeleteUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callDeleteUser(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) GenerateInvite(ctx context.Context, in *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "GenerateInvite")
+	caller := c.callGenerateInvite
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GenerateInviteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GenerateInviteRequest) when calling interceptor")
+					}
+					return c.callGenerateInvite(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GenerateInviteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GenerateInviteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callGenerateInvite(ctx context.Context, in *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+	out := new(GenerateInviteResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) CheckInvite(ctx context.Context, in *CheckInviteRequest) (*CheckInviteResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckInvite")
+	caller := c.callCheckInvite
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckInviteRequest) (*CheckInviteResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckInviteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckInviteRequest) when calling interceptor")
+					}
+					return c.callCheckInvite(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckInviteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckInviteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callCheckInvite(ctx context.Context, in *CheckInviteRequest) (*CheckInviteResponse, error) {
+	out := new(CheckInviteResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListInvites(ctx context.Context, in *ListInvitesRequest) (*ListInvitesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvites")
+	caller := c.callListInvites
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListInvitesRequest) (*ListInvitesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListInvitesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvitesRequest) when calling interceptor")
+					}
+					return c.callListInvites(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListInvitesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvitesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListInvites(ctx context.Context, in *ListInvitesRequest) (*ListInvitesResponse, error) {
+	out := new(ListInvitesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) ListDailyStats(ctx context.Context, in *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDailyStats")
+	caller := c.callListDailyStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDailyStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDailyStatsRequest) when calling interceptor")
+					}
+					return c.callListDailyStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDailyStatsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDailyStatsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callListDailyStats(ctx context.Context, in *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
+	out := new(ListDailyStatsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *userServiceJSONClient) GetConfig(ctx context.Context, in *GetConfigRequest) (*GetConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
+	caller := c.callGetConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetConfigRequest) when calling interceptor")
+					}
+					return c.callGetConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *userServiceJSONClient) callGetConfig(ctx context.Context, in *GetConfigRequest) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// UserService Server Handler
+// ==========================
+
+type userServiceServer struct {
+	UserService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewUserServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewUserServiceServer(svc UserService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &userServiceServer{
+		UserService:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *userServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *userServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// UserServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const UserServicePathPrefix = "/twirp/hotpotatoc.twitter_clone.user.UserService/"
+
+func (s *userServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
+	ctx = ctxsetters.WithServiceName(ctx, "UserService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "hotpotatoc.twitter_clone.user.UserService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "FindUserByID":
+		s.serveFindUserByID(ctx, resp, req)
+		return
+	case "FindUserByEmail":
+		s.serveFindUserByEmail(ctx, resp, req)
+		return
+	case "FindUserSummariesByIDs":
+		s.serveFindUserSummariesByIDs(ctx, resp, req)
+		return
+	case "ListMutualFollows":
+		s.serveListMutualFollows(ctx, resp, req)
+		return
+	case "AutocompleteUsers":
+		s.serveAutocompleteUsers(ctx, resp, req)
+		return
+	case "ListActivityDays":
+		s.serveListActivityDays(ctx, resp, req)
+		return
+	case "ListFollowerGrowth":
+		s.serveListFollowerGrowth(ctx, resp, req)
+		return
+	case "ListTopTweets":
+		s.serveListTopTweets(ctx, resp, req)
+		return
+	case "ListTweetEngagement":
+		s.serveListTweetEngagement(ctx, resp, req)
+		return
+	case "CreateUser":
+		s.serveCreateUser(ctx, resp, req)
+		return
+	case "DeleteUser":
+		s.serveDeleteUser(ctx, resp, req)
+		return
+	case "GenerateInvite":
+		s.serveGenerateInvite(ctx, resp, req)
+		return
+	case "CheckInvite":
+		s.serveCheckInvite(ctx, resp, req)
+		return
+	case "ListInvites":
+		s.serveListInvites(ctx, resp, req)
+		return
+	case "ListDailyStats":
+		s.serveListDailyStats(ctx, resp, req)
+		return
+	case "GetConfig":
+		s.serveGetConfig(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *userServiceServer) serveFindUserByID(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindUserByIDJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindUserByIDProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveFindUserByIDJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserByID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindUserByIDRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.FindUserByID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserByIDRequest) when calling interceptor")
+					}
+					return s.UserService.FindUserByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindUserByIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByIDResponse and nil error while calling FindUserByID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveFindUserByIDProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserByID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindUserByIDRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.FindUserByID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserByIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserByIDRequest) when calling interceptor")
+					}
+					return s.UserService.FindUserByID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserByIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindUserByIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByIDResponse and nil error while calling FindUserByID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveFindUserByEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindUserByEmailJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindUserByEmailProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveFindUserByEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserByEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindUserByEmailRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.FindUserByEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserByEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserByEmailRequest) when calling interceptor")
+					}
+					return s.UserService.FindUserByEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserByEmailResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByEmailResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindUserByEmailResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByEmailResponse and nil error while calling FindUserByEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveFindUserByEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserByEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindUserByEmailRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.FindUserByEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserByEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserByEmailRequest) when calling interceptor")
+					}
+					return s.UserService.FindUserByEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserByEmailResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByEmailResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindUserByEmailResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByEmailResponse and nil error while calling FindUserByEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveFindUserSummariesByIDs(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindUserSummariesByIDsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindUserSummariesByIDsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveFindUserSummariesByIDsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserSummariesByIDs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindUserSummariesByIDsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.FindUserSummariesByIDs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserSummariesByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserSummariesByIDsRequest) when calling interceptor")
+					}
+					return s.UserService.FindUserSummariesByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserSummariesByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserSummariesByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindUserSummariesByIDsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserSummariesByIDsResponse and nil error while calling FindUserSummariesByIDs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveFindUserSummariesByIDsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindUserSummariesByIDs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindUserSummariesByIDsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.FindUserSummariesByIDs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindUserSummariesByIDsRequest) (*FindUserSummariesByIDsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindUserSummariesByIDsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindUserSummariesByIDsRequest) when calling interceptor")
+					}
+					return s.UserService.FindUserSummariesByIDs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindUserSummariesByIDsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindUserSummariesByIDsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindUserSummariesByIDsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserSummariesByIDsResponse and nil error while calling FindUserSummariesByIDs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListMutualFollows(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListMutualFollowsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListMutualFollowsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveListMutualFollowsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListMutualFollows")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListMutualFollowsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.ListMutualFollows
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListMutualFollowsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListMutualFollowsRequest) when calling interceptor")
+					}
+					return s.UserService.ListMutualFollows(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListMutualFollowsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMutualFollowsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListMutualFollowsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListMutualFollowsResponse and nil error while calling ListMutualFollows. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListMutualFollowsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListMutualFollows")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListMutualFollowsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.ListMutualFollows
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListMutualFollowsRequest) (*ListMutualFollowsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListMutualFollowsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListMutualFollowsRequest) when calling interceptor")
+					}
+					return s.UserService.ListMutualFollows(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListMutualFollowsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMutualFollowsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListMutualFollowsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListMutualFollowsResponse and nil error while calling ListMutualFollows. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveAutocompleteUsers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAutocompleteUsersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAutocompleteUsersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveAutocompleteUsersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AutocompleteUsers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AutocompleteUsersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.AutocompleteUsers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AutocompleteUsersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AutocompleteUsersRequest) when calling interceptor")
+					}
+					return s.UserService.AutocompleteUsers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AutocompleteUsersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AutocompleteUsersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AutocompleteUsersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AutocompleteUsersResponse and nil error while calling AutocompleteUsers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveAutocompleteUsersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AutocompleteUsers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AutocompleteUsersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.AutocompleteUsers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AutocompleteUsersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AutocompleteUsersRequest) when calling interceptor")
+					}
+					return s.UserService.AutocompleteUsers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AutocompleteUsersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AutocompleteUsersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AutocompleteUsersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AutocompleteUsersResponse and nil error while calling AutocompleteUsers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListActivityDays(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListActivityDaysJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListActivityDaysProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveListActivityDaysJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListActivityDays")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListActivityDaysRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.ListActivityDays
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListActivityDaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListActivityDaysRequest) when calling interceptor")
+					}
+					return s.UserService.ListActivityDays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListActivityDaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListActivityDaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListActivityDaysResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListActivityDaysResponse and nil error while calling ListActivityDays. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListActivityDaysProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListActivityDays")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListActivityDaysRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.ListActivityDays
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListActivityDaysRequest) (*ListActivityDaysResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListActivityDaysRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListActivityDaysRequest) when calling interceptor")
+					}
+					return s.UserService.ListActivityDays(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListActivityDaysResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListActivityDaysResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListActivityDaysResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListActivityDaysResponse and nil error while calling ListActivityDays. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListFollowerGrowth(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListFollowerGrowthJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListFollowerGrowthProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveListFollowerGrowthJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListFollowerGrowth")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListFollowerGrowthRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.ListFollowerGrowth
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListFollowerGrowthRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListFollowerGrowthRequest) when calling interceptor")
+					}
+					return s.UserService.ListFollowerGrowth(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListFollowerGrowthResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListFollowerGrowthResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListFollowerGrowthResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListFollowerGrowthResponse and nil error while calling ListFollowerGrowth. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListFollowerGrowthProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListFollowerGrowth")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListFollowerGrowthRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.ListFollowerGrowth
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListFollowerGrowthRequest) (*ListFollowerGrowthResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListFollowerGrowthRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListFollowerGrowthRequest) when calling interceptor")
+					}
+					return s.UserService.ListFollowerGrowth(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListFollowerGrowthResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListFollowerGrowthResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListFollowerGrowthResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListFollowerGrowthResponse and nil error while calling ListFollowerGrowth. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListTopTweets(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTopTweetsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTopTweetsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveListTopTweetsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopTweets")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListTopTweetsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.ListTopTweets
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopTweetsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopTweetsRequest) when calling interceptor")
+					}
+					return s.UserService.ListTopTweets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopTweetsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopTweetsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTopTweetsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTopTweetsResponse and nil error while calling ListTopTweets. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListTopTweetsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopTweets")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListTopTweetsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.ListTopTweets
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTopTweetsRequest) (*ListTopTweetsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopTweetsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopTweetsRequest) when calling interceptor")
+					}
+					return s.UserService.ListTopTweets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopTweetsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopTweetsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTopTweetsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTopTweetsResponse and nil error while calling ListTopTweets. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListTweetEngagement(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTweetEngagementJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTweetEngagementProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveListTweetEngagementJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTweetEngagement")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListTweetEngagementRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.ListTweetEngagement
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTweetEngagementRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTweetEngagementRequest) when calling interceptor")
+					}
+					return s.UserService.ListTweetEngagement(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTweetEngagementResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTweetEngagementResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTweetEngagementResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTweetEngagementResponse and nil error while calling ListTweetEngagement. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveListTweetEngagementProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTweetEngagement")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListTweetEngagementRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.ListTweetEngagement
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTweetEngagementRequest) (*ListTweetEngagementResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTweetEngagementRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTweetEngagementRequest) when calling interceptor")
+					}
+					return s.UserService.ListTweetEngagement(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTweetEngagementResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTweetEngagementResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTweetEngagementResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTweetEngagementResponse and nil error while calling ListTweetEngagement. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveCreateUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateUserJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateUserProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveCreateUserJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateUserRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.CreateUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateUserRequest) when calling interceptor")
+					}
+					return s.UserService.CreateUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateUserResponse and nil error while calling CreateUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveCreateUserProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateUserRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.CreateUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateUserRequest) when calling interceptor")
+					}
+					return s.UserService.CreateUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateUserResponse and nil error while calling CreateUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveDeleteUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteUserJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteUserProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveDeleteUserJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteUserRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.UserService.DeleteUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteUserRequest) when calling interceptor")
+					}
+					return s.UserService.DeleteUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteUserResponse and nil error while calling DeleteUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveDeleteUserProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteUserRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.UserService.DeleteUser
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteUserRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteUserRequest) when calling interceptor")
+					}
+					return s.UserService.DeleteUser(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteUserResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteUserResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteUserResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteUserResponse and nil error while calling DeleteUser. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveGenerateInvite(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGenerateInviteJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGenerateInviteProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *userServiceServer) serveGenerateInviteJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GenerateInvite")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(GenerateInviteRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// UserServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const UserServicePathPrefix = "/twirp/hotpotatoc.twitter_clone.user.UserService/"
+	handler := s.UserService.GenerateInvite
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GenerateInviteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GenerateInviteRequest) when calling interceptor")
+					}
+					return s.UserService.GenerateInvite(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GenerateInviteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GenerateInviteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *userServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "hotpotatoc.twitter_clone.user")
-	ctx = ctxsetters.WithServiceName(ctx, "UserService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *GenerateInviteResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GenerateInviteResponse and nil error while calling GenerateInvite. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "hotpotatoc.twitter_clone.user.UserService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *userServiceServer) serveGenerateInviteProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GenerateInvite")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
 
-	switch method {
-	case "FindUserByID":
-		s.serveFindUserByID(ctx, resp, req)
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
-	case "FindUserByEmail":
-		s.serveFindUserByEmail(ctx, resp, req)
+	}
+	reqContent := new(GenerateInviteRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
-	case "CreateUser":
-		s.serveCreateUser(ctx, resp, req)
+	}
+
+	handler := s.UserService.GenerateInvite
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GenerateInviteRequest) (*GenerateInviteResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GenerateInviteRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GenerateInviteRequest) when calling interceptor")
+					}
+					return s.UserService.GenerateInvite(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GenerateInviteResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GenerateInviteResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GenerateInviteResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "DeleteUser":
-		s.serveDeleteUser(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GenerateInviteResponse and nil error while calling GenerateInvite. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveFindUserByID(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveCheckInvite(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -636,9 +4002,9 @@ func (s *userServiceServer) serveFindUserByID(ctx context.Context, resp http.Res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindUserByIDJSON(ctx, resp, req)
+		s.serveCheckInviteJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindUserByIDProtobuf(ctx, resp, req)
+		s.serveCheckInviteProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -646,9 +4012,9 @@ func (s *userServiceServer) serveFindUserByID(ctx context.Context, resp http.Res
 	}
 }
 
-func (s *userServiceServer) serveFindUserByIDJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveCheckInviteJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindUserByID")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckInvite")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -661,29 +4027,29 @@ func (s *userServiceServer) serveFindUserByIDJSON(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(FindUserByIDRequest)
+	reqContent := new(CheckInviteRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.UserService.FindUserByID
+	handler := s.UserService.CheckInvite
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+		handler = func(ctx context.Context, req *CheckInviteRequest) (*CheckInviteResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindUserByIDRequest)
+					typedReq, ok := req.(*CheckInviteRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindUserByIDRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CheckInviteRequest) when calling interceptor")
 					}
-					return s.UserService.FindUserByID(ctx, typedReq)
+					return s.UserService.CheckInvite(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindUserByIDResponse)
+				typedResp, ok := resp.(*CheckInviteResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByIDResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckInviteResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -692,7 +4058,7 @@ func (s *userServiceServer) serveFindUserByIDJSON(ctx context.Context, resp http
 	}
 
 	// Call service method
-	var respContent *FindUserByIDResponse
+	var respContent *CheckInviteResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -703,7 +4069,7 @@ func (s *userServiceServer) serveFindUserByIDJSON(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByIDResponse and nil error while calling FindUserByID. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckInviteResponse and nil error while calling CheckInvite. nil responses are not supported"))
 		return
 	}
 
@@ -729,9 +4095,9 @@ func (s *userServiceServer) serveFindUserByIDJSON(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveFindUserByIDProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveCheckInviteProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindUserByID")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckInvite")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -743,28 +4109,28 @@ func (s *userServiceServer) serveFindUserByIDProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(FindUserByIDRequest)
+	reqContent := new(CheckInviteRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.UserService.FindUserByID
+	handler := s.UserService.CheckInvite
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindUserByIDRequest) (*FindUserByIDResponse, error) {
+		handler = func(ctx context.Context, req *CheckInviteRequest) (*CheckInviteResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindUserByIDRequest)
+					typedReq, ok := req.(*CheckInviteRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindUserByIDRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CheckInviteRequest) when calling interceptor")
 					}
-					return s.UserService.FindUserByID(ctx, typedReq)
+					return s.UserService.CheckInvite(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindUserByIDResponse)
+				typedResp, ok := resp.(*CheckInviteResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByIDResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckInviteResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -773,7 +4139,7 @@ func (s *userServiceServer) serveFindUserByIDProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *FindUserByIDResponse
+	var respContent *CheckInviteResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -784,7 +4150,7 @@ func (s *userServiceServer) serveFindUserByIDProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByIDResponse and nil error while calling FindUserByID. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckInviteResponse and nil error while calling CheckInvite. nil responses are not supported"))
 		return
 	}
 
@@ -808,7 +4174,7 @@ func (s *userServiceServer) serveFindUserByIDProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveFindUserByEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveListInvites(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -816,9 +4182,9 @@ func (s *userServiceServer) serveFindUserByEmail(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindUserByEmailJSON(ctx, resp, req)
+		s.serveListInvitesJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindUserByEmailProtobuf(ctx, resp, req)
+		s.serveListInvitesProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -826,9 +4192,9 @@ func (s *userServiceServer) serveFindUserByEmail(ctx context.Context, resp http.
 	}
 }
 
-func (s *userServiceServer) serveFindUserByEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveListInvitesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindUserByEmail")
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvites")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -841,29 +4207,29 @@ func (s *userServiceServer) serveFindUserByEmailJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(FindUserByEmailRequest)
+	reqContent := new(ListInvitesRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.UserService.FindUserByEmail
+	handler := s.UserService.ListInvites
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+		handler = func(ctx context.Context, req *ListInvitesRequest) (*ListInvitesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindUserByEmailRequest)
+					typedReq, ok := req.(*ListInvitesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindUserByEmailRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvitesRequest) when calling interceptor")
 					}
-					return s.UserService.FindUserByEmail(ctx, typedReq)
+					return s.UserService.ListInvites(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindUserByEmailResponse)
+				typedResp, ok := resp.(*ListInvitesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByEmailResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvitesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -872,7 +4238,7 @@ func (s *userServiceServer) serveFindUserByEmailJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *FindUserByEmailResponse
+	var respContent *ListInvitesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -883,7 +4249,7 @@ func (s *userServiceServer) serveFindUserByEmailJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByEmailResponse and nil error while calling FindUserByEmail. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListInvitesResponse and nil error while calling ListInvites. nil responses are not supported"))
 		return
 	}
 
@@ -909,9 +4275,9 @@ func (s *userServiceServer) serveFindUserByEmailJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveFindUserByEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveListInvitesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindUserByEmail")
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvites")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -923,28 +4289,28 @@ func (s *userServiceServer) serveFindUserByEmailProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(FindUserByEmailRequest)
+	reqContent := new(ListInvitesRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.UserService.FindUserByEmail
+	handler := s.UserService.ListInvites
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindUserByEmailRequest) (*FindUserByEmailResponse, error) {
+		handler = func(ctx context.Context, req *ListInvitesRequest) (*ListInvitesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindUserByEmailRequest)
+					typedReq, ok := req.(*ListInvitesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindUserByEmailRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvitesRequest) when calling interceptor")
 					}
-					return s.UserService.FindUserByEmail(ctx, typedReq)
+					return s.UserService.ListInvites(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindUserByEmailResponse)
+				typedResp, ok := resp.(*ListInvitesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindUserByEmailResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvitesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -953,7 +4319,7 @@ func (s *userServiceServer) serveFindUserByEmailProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *FindUserByEmailResponse
+	var respContent *ListInvitesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -964,7 +4330,7 @@ func (s *userServiceServer) serveFindUserByEmailProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindUserByEmailResponse and nil error while calling FindUserByEmail. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListInvitesResponse and nil error while calling ListInvites. nil responses are not supported"))
 		return
 	}
 
@@ -988,7 +4354,7 @@ func (s *userServiceServer) serveFindUserByEmailProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveCreateUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveListDailyStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -996,9 +4362,9 @@ func (s *userServiceServer) serveCreateUser(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateUserJSON(ctx, resp, req)
+		s.serveListDailyStatsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateUserProtobuf(ctx, resp, req)
+		s.serveListDailyStatsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1006,9 +4372,9 @@ func (s *userServiceServer) serveCreateUser(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *userServiceServer) serveCreateUserJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveListDailyStatsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDailyStats")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1021,29 +4387,29 @@ func (s *userServiceServer) serveCreateUserJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateUserRequest)
+	reqContent := new(ListDailyStatsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.UserService.CreateUser
+	handler := s.UserService.ListDailyStats
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+		handler = func(ctx context.Context, req *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateUserRequest)
+					typedReq, ok := req.(*ListDailyStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateUserRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListDailyStatsRequest) when calling interceptor")
 					}
-					return s.UserService.CreateUser(ctx, typedReq)
+					return s.UserService.ListDailyStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateUserResponse)
+				typedResp, ok := resp.(*ListDailyStatsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateUserResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDailyStatsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1052,7 +4418,7 @@ func (s *userServiceServer) serveCreateUserJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *CreateUserResponse
+	var respContent *ListDailyStatsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1063,7 +4429,7 @@ func (s *userServiceServer) serveCreateUserJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateUserResponse and nil error while calling CreateUser. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDailyStatsResponse and nil error while calling ListDailyStats. nil responses are not supported"))
 		return
 	}
 
@@ -1089,9 +4455,9 @@ func (s *userServiceServer) serveCreateUserJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveCreateUserProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveListDailyStatsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateUser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDailyStats")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1103,28 +4469,28 @@ func (s *userServiceServer) serveCreateUserProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateUserRequest)
+	reqContent := new(ListDailyStatsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.UserService.CreateUser
+	handler := s.UserService.ListDailyStats
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+		handler = func(ctx context.Context, req *ListDailyStatsRequest) (*ListDailyStatsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateUserRequest)
+					typedReq, ok := req.(*ListDailyStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateUserRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListDailyStatsRequest) when calling interceptor")
 					}
-					return s.UserService.CreateUser(ctx, typedReq)
+					return s.UserService.ListDailyStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateUserResponse)
+				typedResp, ok := resp.(*ListDailyStatsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateUserResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDailyStatsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1133,7 +4499,7 @@ func (s *userServiceServer) serveCreateUserProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *CreateUserResponse
+	var respContent *ListDailyStatsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1144,7 +4510,7 @@ func (s *userServiceServer) serveCreateUserProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateUserResponse and nil error while calling CreateUser. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDailyStatsResponse and nil error while calling ListDailyStats. nil responses are not supported"))
 		return
 	}
 
@@ -1168,7 +4534,7 @@ func (s *userServiceServer) serveCreateUserProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveDeleteUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveGetConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1176,9 +4542,9 @@ func (s *userServiceServer) serveDeleteUser(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDeleteUserJSON(ctx, resp, req)
+		s.serveGetConfigJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDeleteUserProtobuf(ctx, resp, req)
+		s.serveGetConfigProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1186,9 +4552,9 @@ func (s *userServiceServer) serveDeleteUser(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *userServiceServer) serveDeleteUserJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveGetConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1201,29 +4567,29 @@ func (s *userServiceServer) serveDeleteUserJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(DeleteUserRequest)
+	reqContent := new(GetConfigRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.UserService.DeleteUser
+	handler := s.UserService.GetConfig
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+		handler = func(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteUserRequest)
+					typedReq, ok := req.(*GetConfigRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteUserRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetConfigRequest) when calling interceptor")
 					}
-					return s.UserService.DeleteUser(ctx, typedReq)
+					return s.UserService.GetConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteUserResponse)
+				typedResp, ok := resp.(*GetConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteUserResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1232,7 +4598,7 @@ func (s *userServiceServer) serveDeleteUserJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *DeleteUserResponse
+	var respContent *GetConfigResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1243,7 +4609,7 @@ func (s *userServiceServer) serveDeleteUserJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteUserResponse and nil error while calling DeleteUser. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetConfigResponse and nil error while calling GetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -1269,9 +4635,9 @@ func (s *userServiceServer) serveDeleteUserJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *userServiceServer) serveDeleteUserProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *userServiceServer) serveGetConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteUser")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1283,28 +4649,28 @@ func (s *userServiceServer) serveDeleteUserProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(DeleteUserRequest)
+	reqContent := new(GetConfigRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.UserService.DeleteUser
+	handler := s.UserService.GetConfig
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+		handler = func(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteUserRequest)
+					typedReq, ok := req.(*GetConfigRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteUserRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetConfigRequest) when calling interceptor")
 					}
-					return s.UserService.DeleteUser(ctx, typedReq)
+					return s.UserService.GetConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteUserResponse)
+				typedResp, ok := resp.(*GetConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteUserResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1313,7 +4679,7 @@ func (s *userServiceServer) serveDeleteUserProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *DeleteUserResponse
+	var respContent *GetConfigResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1324,7 +4690,7 @@ func (s *userServiceServer) serveDeleteUserProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteUserResponse and nil error while calling DeleteUser. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetConfigResponse and nil error while calling GetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -1929,46 +5295,126 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x95, 0xe6, 0x7b, 0xd2, 0x36, 0xed, 0xb6, 0xa2, 0x56, 0x24, 0xd4, 0x2a, 0x3d, 0x50,
-	0x50, 0xe5, 0x42, 0x2b, 0x40, 0x3d, 0xf6, 0x03, 0x44, 0x0f, 0x80, 0x64, 0xe8, 0x85, 0x8b, 0xb5,
-	0xb1, 0xa7, 0xc9, 0x4a, 0x8e, 0xd7, 0xdd, 0x5d, 0x13, 0x71, 0xe3, 0xa9, 0x78, 0x15, 0x1e, 0x83,
-	0x57, 0x40, 0xbb, 0xf6, 0xd6, 0x29, 0x69, 0x70, 0xa2, 0x5e, 0x22, 0xcf, 0xcc, 0x7f, 0x3e, 0xec,
-	0xfd, 0xed, 0x04, 0xb6, 0x44, 0x12, 0x1c, 0xa5, 0x12, 0x85, 0xf9, 0x71, 0x13, 0xc1, 0x15, 0x27,
-	0x4f, 0x47, 0x5c, 0x25, 0x5c, 0x51, 0xc5, 0x03, 0x57, 0x4d, 0x98, 0x52, 0x28, 0xfc, 0x20, 0xe2,
-	0x31, 0xba, 0x5a, 0xd4, 0xdb, 0x1d, 0x72, 0x3e, 0x8c, 0xf0, 0xc8, 0x88, 0x07, 0xe9, 0xcd, 0x91,
-	0x62, 0x63, 0x94, 0x8a, 0x8e, 0x93, 0x2c, 0xbf, 0xef, 0xc2, 0xd6, 0x7b, 0x16, 0x87, 0xd7, 0x12,
-	0xc5, 0xf9, 0x8f, 0xab, 0x4b, 0x0f, 0x6f, 0x53, 0x94, 0x8a, 0xec, 0x40, 0x53, 0xe7, 0xfb, 0x2c,
-	0x74, 0x2a, 0x7b, 0x95, 0x83, 0xb6, 0xd7, 0xd0, 0xe6, 0x55, 0xd8, 0xff, 0x0c, 0xdb, 0xf7, 0xf5,
-	0x32, 0xe1, 0xb1, 0x44, 0xf2, 0x16, 0x6a, 0x5a, 0x61, 0xd4, 0x9d, 0xe3, 0x7d, 0xf7, 0xbf, 0x63,
-	0xb9, 0x3a, 0xdd, 0x33, 0x09, 0x7d, 0x17, 0x9e, 0x14, 0x05, 0xdf, 0x8d, 0x29, 0x8b, 0xec, 0x0c,
-	0xdb, 0x50, 0x47, 0x6d, 0xe7, 0x13, 0x64, 0x46, 0xdf, 0x83, 0x9d, 0x19, 0xfd, 0x63, 0x67, 0xf8,
-	0xbd, 0x02, 0x9b, 0x17, 0x02, 0xa9, 0x42, 0xe3, 0xcc, 0xfb, 0x13, 0xa8, 0xc5, 0x74, 0x8c, 0x79,
-	0x7b, 0xf3, 0x4c, 0x76, 0xa1, 0x23, 0x03, 0x81, 0x18, 0xfb, 0x26, 0xb4, 0x62, 0x42, 0x90, 0xb9,
-	0x3e, 0x69, 0x41, 0x0f, 0x5a, 0x09, 0x95, 0x72, 0xc2, 0x45, 0xe8, 0x54, 0x4d, 0xf4, 0xce, 0x2e,
-	0x5e, 0xa8, 0x36, 0xf5, 0x42, 0x64, 0x03, 0xaa, 0x03, 0xc6, 0x9d, 0xba, 0xf1, 0xe9, 0x47, 0x5d,
-	0x23, 0xe2, 0x01, 0x55, 0x8c, 0xc7, 0x4e, 0x23, 0xab, 0x61, 0x6d, 0xe2, 0x40, 0x73, 0x82, 0x03,
-	0xc9, 0x14, 0x3a, 0x4d, 0x13, 0xb2, 0x26, 0x79, 0x01, 0x9b, 0x89, 0xe0, 0x37, 0x2c, 0x42, 0x9f,
-	0x8d, 0xe9, 0x10, 0xfd, 0x54, 0x44, 0x4e, 0xcb, 0x68, 0xba, 0x79, 0xe0, 0x4a, 0xfb, 0xaf, 0x45,
-	0x44, 0x0e, 0x81, 0x58, 0xed, 0x80, 0xc6, 0x31, 0x0a, 0x23, 0x6e, 0x1b, 0xf1, 0x46, 0x1e, 0x39,
-	0x37, 0x01, 0xad, 0x3e, 0x05, 0x18, 0x30, 0xa1, 0x46, 0x7e, 0x48, 0x15, 0x3a, 0x60, 0xbe, 0x6e,
-	0xcf, 0xcd, 0xc8, 0x72, 0x2d, 0x59, 0xee, 0x57, 0x4b, 0x96, 0xd7, 0x36, 0xea, 0x4b, 0xaa, 0xb0,
-	0xff, 0x11, 0xc8, 0xf4, 0x87, 0x7d, 0xec, 0x41, 0x1d, 0xc2, 0xe6, 0x25, 0x46, 0x78, 0xff, 0x9c,
-	0xe6, 0xb2, 0xea, 0x02, 0x99, 0x56, 0xe7, 0xcd, 0x1d, 0x68, 0xca, 0x34, 0x08, 0x50, 0x4a, 0x23,
-	0x6f, 0x79, 0xd6, 0xec, 0xff, 0xaa, 0x41, 0x4d, 0x4b, 0xe7, 0x56, 0xbc, 0x43, 0x62, 0x65, 0x3e,
-	0x12, 0xd5, 0x19, 0x24, 0xf6, 0x61, 0xcd, 0x22, 0xe0, 0x8f, 0xa8, 0x1c, 0xe5, 0xc7, 0xbf, 0x6a,
-	0x9d, 0x1f, 0xa8, 0x1c, 0x15, 0x6c, 0xd4, 0x1f, 0x60, 0xa3, 0xf1, 0x30, 0x1b, 0xcd, 0xf9, 0x6c,
-	0xb4, 0x16, 0x60, 0xa3, 0xbd, 0x0c, 0x1b, 0xb0, 0x10, 0x1b, 0x9d, 0x25, 0xd8, 0x20, 0xcf, 0xa0,
-	0x7b, 0xc3, 0xa3, 0x88, 0x4f, 0x50, 0x48, 0x3f, 0xe0, 0x69, 0xac, 0x9c, 0xd5, 0xbd, 0xca, 0x41,
-	0xdd, 0x5b, 0xbf, 0x73, 0x5f, 0x68, 0x2f, 0x79, 0x0e, 0x1b, 0x99, 0x87, 0xc5, 0x43, 0xab, 0x5c,
-	0x33, 0xca, 0x6e, 0xe1, 0xcf, 0xa4, 0xa7, 0x00, 0x81, 0xe1, 0x2d, 0xf4, 0xa9, 0x72, 0xd6, 0xcb,
-	0xc7, 0xc9, 0xd5, 0x67, 0x26, 0x35, 0x4d, 0x42, 0x9b, 0xda, 0x2d, 0x4f, 0xcd, 0xd5, 0x67, 0xea,
-	0xf8, 0x4f, 0x15, 0x3a, 0x1a, 0x9c, 0x2f, 0x28, 0xbe, 0xb3, 0x00, 0xc9, 0x04, 0x56, 0xa7, 0x97,
-	0x24, 0x39, 0x2e, 0x21, 0xfc, 0x81, 0x0d, 0xdc, 0x3b, 0x59, 0x2a, 0x27, 0x67, 0xfb, 0x67, 0x05,
-	0xba, 0xff, 0x6c, 0x47, 0xf2, 0x7a, 0xe1, 0x42, 0xd3, 0xdb, 0xb7, 0xf7, 0x66, 0xd9, 0xb4, 0x7c,
-	0x84, 0x5b, 0x80, 0xe2, 0xc6, 0x93, 0x97, 0x25, 0x55, 0x66, 0xb6, 0x6e, 0xef, 0xd5, 0x12, 0x19,
-	0x45, 0xcb, 0xe2, 0x9e, 0x97, 0xb6, 0x9c, 0x59, 0x20, 0xa5, 0x2d, 0x67, 0x97, 0xc8, 0x39, 0x7c,
-	0x6b, 0xd9, 0x7f, 0xe3, 0x41, 0xc3, 0xc0, 0x71, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x94,
-	0x36, 0x74, 0xa0, 0x07, 0x00, 0x00,
+	// 1925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5b, 0x8f, 0x1b, 0x49,
+	0x15, 0x56, 0xfb, 0x32, 0xb6, 0x8f, 0xe7, 0x5a, 0x99, 0x24, 0x4e, 0x87, 0xb0, 0xa1, 0xa3, 0xd5,
+	0x66, 0x97, 0x95, 0xb3, 0x99, 0x90, 0xcd, 0x45, 0x0b, 0x64, 0x2e, 0xd9, 0xec, 0x08, 0x02, 0xa2,
+	0x37, 0x41, 0x10, 0x04, 0x56, 0x4f, 0xfb, 0x8c, 0xa7, 0xe5, 0x76, 0x97, 0xd3, 0x55, 0x3d, 0x8e,
+	0x17, 0x11, 0x21, 0x21, 0x2d, 0x20, 0xf1, 0xc0, 0x0b, 0xf0, 0x07, 0x78, 0xe2, 0x89, 0x1f, 0x85,
+	0xf8, 0x07, 0xbc, 0xa3, 0xba, 0xb4, 0xbb, 0xdb, 0xee, 0x49, 0xbb, 0x27, 0xd1, 0xbe, 0x8c, 0x7c,
+	0x4e, 0x9f, 0xfb, 0xa9, 0xfa, 0xaa, 0xea, 0x0c, 0x5c, 0x08, 0xc7, 0xee, 0xad, 0x88, 0x61, 0x28,
+	0xff, 0x74, 0xc7, 0x21, 0xe5, 0x94, 0x5c, 0x3b, 0xa1, 0x7c, 0x4c, 0xb9, 0xc3, 0xa9, 0xdb, 0xe5,
+	0x13, 0x8f, 0x73, 0x0c, 0x7b, 0xae, 0x4f, 0x03, 0xec, 0x0a, 0x21, 0xf3, 0xbd, 0x01, 0xa5, 0x03,
+	0x1f, 0x6f, 0x49, 0xe1, 0xa3, 0xe8, 0xf8, 0x16, 0xf7, 0x46, 0xc8, 0xb8, 0x33, 0x1a, 0x2b, 0x7d,
+	0xeb, 0x47, 0x70, 0xe1, 0x73, 0x2f, 0xe8, 0x3f, 0x67, 0x18, 0xee, 0x4d, 0x0f, 0x0f, 0x6c, 0x7c,
+	0x19, 0x21, 0xe3, 0xe4, 0x32, 0x34, 0x84, 0x7e, 0xcf, 0xeb, 0x77, 0x8c, 0xeb, 0xc6, 0xcd, 0x96,
+	0xbd, 0x22, 0xc8, 0xc3, 0x3e, 0xb9, 0x0a, 0xad, 0x53, 0x0f, 0x27, 0xea, 0x53, 0x45, 0x7e, 0x6a,
+	0x2a, 0xc6, 0x61, 0xdf, 0x1a, 0xc3, 0x76, 0xd6, 0x18, 0x1b, 0xd3, 0x80, 0x21, 0xb9, 0x07, 0x35,
+	0xa1, 0x2e, 0x4d, 0xb5, 0x77, 0x6e, 0x74, 0xdf, 0x18, 0x73, 0x57, 0xa8, 0xdb, 0x52, 0x81, 0xbc,
+	0x07, 0xed, 0x63, 0xea, 0xfb, 0x74, 0xc2, 0x7a, 0x53, 0x1a, 0x49, 0x7f, 0x4d, 0x1b, 0x34, 0xeb,
+	0x97, 0x34, 0xb2, 0xba, 0x70, 0x29, 0xf1, 0xf8, 0x78, 0xe4, 0x78, 0x7e, 0x9c, 0xc1, 0x36, 0xd4,
+	0x51, 0xd0, 0x3a, 0x7e, 0x45, 0x58, 0x36, 0x5c, 0x5e, 0x90, 0x7f, 0xcb, 0x20, 0xad, 0x87, 0x70,
+	0x2d, 0xb6, 0xf9, 0x65, 0x34, 0x1a, 0x39, 0xa1, 0x87, 0x4c, 0xa4, 0xcf, 0xe2, 0x50, 0xae, 0x40,
+	0x53, 0x17, 0x93, 0x75, 0x8c, 0xeb, 0xd5, 0x9b, 0x2d, 0xbb, 0xa1, 0xaa, 0xc9, 0xac, 0xff, 0x1a,
+	0xf0, 0xed, 0xb3, 0x94, 0x75, 0x5c, 0xbf, 0x81, 0xba, 0x90, 0x56, 0xaa, 0xed, 0x9d, 0x2f, 0x0a,
+	0x02, 0x7b, 0xb3, 0x35, 0x19, 0x37, 0x7b, 0x1c, 0xf0, 0x70, 0x6a, 0x2b, 0xb3, 0x66, 0x1f, 0x20,
+	0x61, 0x92, 0x4d, 0xa8, 0x0e, 0x71, 0xaa, 0x8b, 0x26, 0x7e, 0x92, 0x47, 0x50, 0x3f, 0x75, 0xfc,
+	0x08, 0x65, 0xf5, 0xdb, 0x3b, 0x1f, 0x2d, 0x51, 0x18, 0xe5, 0x7b, 0x6a, 0x2b, 0xc5, 0x87, 0x95,
+	0xfb, 0x86, 0xf5, 0x1a, 0x3a, 0x3f, 0xf6, 0x18, 0x7f, 0x1a, 0xf1, 0xc8, 0xf1, 0x3f, 0x57, 0x0d,
+	0x8c, 0xeb, 0x93, 0x59, 0x53, 0x46, 0x76, 0x4d, 0xa5, 0x57, 0x62, 0x25, 0xb3, 0x12, 0xb7, 0xa1,
+	0xee, 0x7b, 0x23, 0x8f, 0x77, 0xaa, 0xd7, 0x8d, 0x9b, 0x75, 0x5b, 0x11, 0xe4, 0x12, 0xac, 0xb8,
+	0x51, 0xc8, 0x68, 0xd8, 0xa9, 0x29, 0x69, 0x45, 0x59, 0xaf, 0xe1, 0x4a, 0x8e, 0x7f, 0x5d, 0xe2,
+	0x47, 0xd9, 0x12, 0x97, 0x4a, 0x51, 0x2a, 0x8a, 0x85, 0x1a, 0xe0, 0x2b, 0xde, 0xd3, 0xbe, 0x55,
+	0xa4, 0x20, 0x58, 0xfb, 0xca, 0xbf, 0x0b, 0x9d, 0xdd, 0x88, 0x53, 0x97, 0x8e, 0xc6, 0x3e, 0x72,
+	0x94, 0x15, 0x5f, 0x2a, 0xff, 0x6d, 0xa8, 0xbf, 0x8c, 0x30, 0x9c, 0x6a, 0x9b, 0x8a, 0xc8, 0x4f,
+	0xde, 0xfa, 0x35, 0x5c, 0xc9, 0x71, 0xf2, 0xae, 0x92, 0xb4, 0x86, 0x70, 0x59, 0xd4, 0x70, 0xd7,
+	0xe5, 0xde, 0xa9, 0xc7, 0xa7, 0x07, 0xce, 0x94, 0x15, 0xe2, 0xc5, 0x36, 0xd4, 0x27, 0x88, 0x43,
+	0x26, 0xc3, 0xaf, 0xdb, 0x8a, 0x20, 0xdf, 0x81, 0xd5, 0x50, 0x69, 0x2a, 0x9d, 0xaa, 0xd4, 0x69,
+	0xcf, 0x78, 0x87, 0x7d, 0xeb, 0x05, 0x74, 0x16, 0x9d, 0xe9, 0x54, 0x7e, 0x00, 0xb5, 0xbe, 0x33,
+	0x5d, 0x36, 0x93, 0x94, 0x09, 0x5b, 0xea, 0x59, 0xff, 0x30, 0xd4, 0x6a, 0x50, 0xeb, 0x00, 0xc3,
+	0x27, 0x21, 0x9d, 0xf0, 0x93, 0xc2, 0x5c, 0xe6, 0xa3, 0xae, 0x2c, 0x44, 0x4d, 0x08, 0xd4, 0x8e,
+	0x43, 0x3a, 0xd2, 0x09, 0xc9, 0xdf, 0x64, 0x1d, 0x2a, 0x9c, 0xea, 0xe5, 0x58, 0xe1, 0x94, 0x98,
+	0xd0, 0xf4, 0x02, 0x8e, 0xe1, 0xa9, 0xe3, 0x77, 0xea, 0xaa, 0xdb, 0x31, 0x6d, 0x0d, 0xc1, 0xcc,
+	0x0b, 0x4c, 0xe7, 0xfd, 0x14, 0x1a, 0x47, 0x91, 0x3b, 0x44, 0x1e, 0xa7, 0x7e, 0xa7, 0x08, 0x0c,
+	0x32, 0x76, 0xf6, 0xa4, 0xae, 0x1d, 0xdb, 0xb0, 0xfe, 0x69, 0xc0, 0xb6, 0xf0, 0xf6, 0x8c, 0x8e,
+	0x9f, 0x4d, 0x10, 0x39, 0xfb, 0xa6, 0x2a, 0x30, 0x5b, 0xbd, 0xf5, 0xfc, 0xad, 0xbb, 0x92, 0xd9,
+	0xba, 0x53, 0xb8, 0x38, 0x17, 0xa5, 0x2e, 0xc7, 0x0f, 0x61, 0x85, 0x4f, 0x30, 0xa9, 0xc6, 0x07,
+	0x05, 0xd5, 0x88, 0x2d, 0xd8, 0x5a, 0xad, 0x78, 0xd7, 0xbe, 0x56, 0xed, 0x90, 0x5a, 0x8f, 0x83,
+	0x81, 0x33, 0xc0, 0x11, 0x06, 0x3c, 0x85, 0xeb, 0xd2, 0x50, 0x52, 0xa7, 0x86, 0xa4, 0xdf, 0x59,
+	0xa1, 0xac, 0x3e, 0x5c, 0xcd, 0xf5, 0xaf, 0x0b, 0xf0, 0x38, 0xb3, 0x0f, 0x6e, 0x17, 0xa5, 0x9f,
+	0xb5, 0x92, 0x6c, 0x87, 0xbf, 0x57, 0x61, 0x6b, 0x3f, 0x44, 0x47, 0x21, 0x46, 0x9c, 0x1d, 0x81,
+	0x5a, 0xe0, 0x8c, 0x50, 0x67, 0x26, 0x7f, 0x8b, 0x82, 0x31, 0x37, 0x44, 0x0c, 0x7a, 0xf2, 0x93,
+	0x2e, 0x98, 0x62, 0xfd, 0x44, 0x08, 0x98, 0xd0, 0x1c, 0x3b, 0x8c, 0x4d, 0x68, 0x18, 0x6f, 0xea,
+	0x19, 0x9d, 0x9c, 0xc8, 0xb5, 0xd4, 0x89, 0x2c, 0x0e, 0x9c, 0x23, 0x8f, 0xea, 0x8d, 0x20, 0x7e,
+	0x0a, 0x1b, 0x3e, 0x75, 0x1d, 0xee, 0xd1, 0x40, 0xaf, 0x84, 0x19, 0x4d, 0x3a, 0xd0, 0x98, 0xe0,
+	0x11, 0xf3, 0x38, 0x76, 0x1a, 0xaa, 0xe2, 0x9a, 0x24, 0x1f, 0xc1, 0xd6, 0x38, 0xa4, 0xc7, 0x9e,
+	0x8f, 0x3d, 0x6f, 0xe4, 0x0c, 0xb0, 0x17, 0x85, 0x7e, 0xa7, 0x29, 0x65, 0x36, 0xf4, 0x87, 0x43,
+	0xc1, 0x7f, 0x1e, 0xfa, 0xe4, 0x63, 0x20, 0xb1, 0xec, 0x91, 0x13, 0x04, 0x18, 0x4a, 0xe1, 0x96,
+	0x14, 0xde, 0xd4, 0x5f, 0xf6, 0xe4, 0x07, 0x21, 0xfd, 0x00, 0xe0, 0xc8, 0x0b, 0xf9, 0x49, 0xaf,
+	0xef, 0x70, 0xec, 0x80, 0x3c, 0x05, 0xcd, 0xae, 0xba, 0x58, 0x75, 0xe3, 0x8b, 0x55, 0xf7, 0x59,
+	0x7c, 0xb1, 0xb2, 0x5b, 0x52, 0xfa, 0xc0, 0xe1, 0x28, 0x96, 0x81, 0x17, 0x9c, 0x7a, 0x1c, 0x7b,
+	0x9c, 0x0e, 0x31, 0xe8, 0xb4, 0xd5, 0x32, 0x50, 0xbc, 0x67, 0x82, 0x25, 0xb2, 0x15, 0x77, 0xb2,
+	0xaf, 0x68, 0x80, 0x9d, 0x55, 0x95, 0x6d, 0x4c, 0x5b, 0x4f, 0x81, 0xa4, 0xfb, 0xf2, 0xb6, 0x17,
+	0x95, 0x8f, 0x61, 0xeb, 0x00, 0x7d, 0xcc, 0xb6, 0xf9, 0xac, 0xbd, 0x6e, 0x75, 0x81, 0xa4, 0xa5,
+	0xb5, 0xf3, 0x0e, 0x34, 0x58, 0xe4, 0xba, 0xc8, 0x98, 0x14, 0x6f, 0xda, 0x31, 0x69, 0x7d, 0x6d,
+	0xc0, 0xc5, 0x27, 0x18, 0x60, 0xe8, 0x70, 0x3c, 0x94, 0x09, 0x16, 0xc2, 0xc9, 0x15, 0x68, 0x8e,
+	0x9c, 0x57, 0xbd, 0x88, 0x61, 0x7c, 0x3e, 0x34, 0x46, 0xce, 0xab, 0xe7, 0x0c, 0x99, 0x28, 0x3a,
+	0xbe, 0x1a, 0x7b, 0x21, 0xb2, 0x9e, 0xa3, 0x4e, 0xb9, 0x82, 0xa2, 0x6b, 0xe9, 0x5d, 0x6e, 0xfd,
+	0x0a, 0x2e, 0xcd, 0xc7, 0xa1, 0x83, 0xdf, 0x85, 0x15, 0x55, 0x7a, 0x5d, 0xbb, 0x0f, 0x0b, 0x6a,
+	0xa7, 0xd4, 0xf7, 0x69, 0x1f, 0x6d, 0xad, 0x68, 0xdd, 0x03, 0xb2, 0x7f, 0x82, 0xee, 0x30, 0x9b,
+	0xe1, 0x7c, 0x9f, 0x8d, 0x85, 0x3e, 0x5b, 0x17, 0xe1, 0x42, 0x46, 0x51, 0x85, 0x64, 0xfd, 0xc9,
+	0x00, 0x22, 0xb6, 0xb8, 0x62, 0xcf, 0x10, 0xf8, 0xfd, 0x39, 0xfc, 0x90, 0x06, 0xf7, 0x2a, 0x1d,
+	0x23, 0x8b, 0x21, 0xd7, 0x00, 0x94, 0x8f, 0x14, 0xc8, 0xb4, 0x34, 0xa7, 0xf4, 0x15, 0xe9, 0xb7,
+	0x70, 0x21, 0x13, 0x89, 0x2e, 0xda, 0x3e, 0x34, 0x94, 0xc5, 0x18, 0x67, 0x4a, 0x54, 0x2d, 0xd6,
+	0x2c, 0x46, 0xda, 0x5f, 0x28, 0x90, 0x3f, 0x70, 0x3c, 0x7f, 0xfa, 0x25, 0x77, 0x92, 0xb3, 0x28,
+	0x86, 0x49, 0x63, 0x01, 0x26, 0x2b, 0xb3, 0xf3, 0xe4, 0x2a, 0xb4, 0xc4, 0x9e, 0xe9, 0xc9, 0x4d,
+	0x54, 0x4d, 0x36, 0xd1, 0x0b, 0xb1, 0x89, 0x7e, 0x0e, 0x97, 0xe6, 0x2d, 0xeb, 0xcc, 0x3e, 0xcb,
+	0xc0, 0xe7, 0xcd, 0x82, 0xb4, 0x66, 0x06, 0x34, 0x6a, 0x12, 0xd8, 0x7c, 0x82, 0x7c, 0x9f, 0x06,
+	0xc7, 0xde, 0x40, 0x07, 0x6b, 0x3d, 0x82, 0xad, 0x14, 0x4f, 0xbb, 0xf9, 0x2e, 0x6c, 0x85, 0x38,
+	0xf0, 0x18, 0x0f, 0x25, 0x86, 0xf5, 0xe8, 0x58, 0xaf, 0x90, 0xa6, 0xbd, 0x99, 0xfe, 0xf0, 0xd3,
+	0x31, 0x06, 0xd6, 0xff, 0x6a, 0x50, 0x13, 0x1b, 0xee, 0xec, 0x4d, 0x13, 0xe3, 0x72, 0xe5, 0x6c,
+	0x5c, 0xae, 0x2e, 0xe0, 0xf2, 0x0d, 0x58, 0x8b, 0x71, 0xb8, 0x77, 0xe2, 0xb0, 0x13, 0xdd, 0xfa,
+	0xd5, 0x98, 0xf9, 0x85, 0xc3, 0x4e, 0x12, 0x80, 0xae, 0xe7, 0x00, 0xf4, 0x4a, 0x3e, 0x40, 0x37,
+	0xce, 0x06, 0xe8, 0xe6, 0x12, 0x00, 0xdd, 0x2a, 0x03, 0xd0, 0xb0, 0x14, 0x40, 0xb7, 0xcb, 0x00,
+	0xf4, 0x07, 0xb0, 0x71, 0xac, 0xef, 0x48, 0xac, 0xe7, 0xd2, 0x28, 0xe0, 0x12, 0x84, 0xeb, 0xf6,
+	0xfa, 0x8c, 0xbd, 0x2f, 0xb8, 0xe4, 0x43, 0xd8, 0x54, 0x1c, 0x2f, 0x18, 0xc4, 0x92, 0x6b, 0x52,
+	0x72, 0x23, 0xe1, 0x2b, 0xd1, 0x07, 0x00, 0xae, 0x44, 0xed, 0xbe, 0x80, 0xae, 0xf5, 0xe2, 0x70,
+	0xb4, 0xf4, 0xae, 0x54, 0x8d, 0xc6, 0xfd, 0x58, 0x75, 0xa3, 0x58, 0x55, 0x4b, 0xef, 0x4a, 0x08,
+	0x52, 0xb7, 0x1a, 0x1d, 0xdc, 0xa6, 0x0c, 0xae, 0xad, 0x78, 0x2a, 0xb0, 0xf4, 0x51, 0xb3, 0x35,
+	0x77, 0xd4, 0xfc, 0xd1, 0x80, 0x76, 0xea, 0xca, 0xff, 0x8e, 0x97, 0x5f, 0x6e, 0xef, 0x6b, 0xb9,
+	0xbd, 0xb7, 0xfe, 0x63, 0x00, 0x24, 0x10, 0x22, 0xfc, 0xb9, 0xb4, 0x3f, 0xbb, 0x86, 0x88, 0xdf,
+	0x45, 0xb0, 0x77, 0xfe, 0xb3, 0x63, 0xae, 0x77, 0xb5, 0x32, 0xbd, 0x4b, 0x1f, 0x66, 0xf5, 0xec,
+	0x61, 0x46, 0xe4, 0x89, 0xcd, 0xe4, 0x1e, 0xaa, 0xcb, 0xc3, 0x98, 0x59, 0xff, 0x36, 0xa0, 0x9d,
+	0x7a, 0x99, 0x08, 0x19, 0xb9, 0x7c, 0x75, 0x9e, 0xe2, 0xb7, 0x40, 0x6a, 0x7d, 0xc1, 0x55, 0xa7,
+	0xa3, 0xa6, 0xc8, 0xfb, 0xb0, 0xee, 0x7b, 0x43, 0x64, 0xbd, 0x10, 0x5d, 0xf4, 0x4e, 0xb1, 0xaf,
+	0x01, 0x7e, 0x4d, 0x72, 0x6d, 0xcd, 0x14, 0x6b, 0x36, 0xc4, 0xb1, 0xef, 0xa5, 0x05, 0x6b, 0x6a,
+	0xcd, 0x6a, 0xfe, 0x4c, 0xf4, 0x06, 0xac, 0x05, 0x38, 0xe9, 0xcd, 0x16, 0xbd, 0xce, 0x60, 0x35,
+	0xc0, 0x49, 0xfc, 0x86, 0x60, 0x16, 0x85, 0xed, 0xbc, 0x07, 0x85, 0xc0, 0x0d, 0xc6, 0x9d, 0x90,
+	0xc7, 0xa3, 0x16, 0x49, 0x2c, 0x9a, 0xac, 0x2c, 0x9a, 0x24, 0xdf, 0x82, 0x56, 0x22, 0xa0, 0x92,
+	0x48, 0x18, 0xd6, 0xbf, 0x0c, 0x68, 0xc6, 0x97, 0xf6, 0x37, 0xdd, 0xb6, 0x3b, 0xd0, 0x70, 0x69,
+	0xc0, 0x31, 0xe0, 0x7a, 0x31, 0xc4, 0xe4, 0x5c, 0x3f, 0xab, 0x65, 0xfa, 0x29, 0x0f, 0xcf, 0x21,
+	0x32, 0x5d, 0x32, 0x45, 0x08, 0x57, 0xba, 0x76, 0x71, 0x93, 0x35, 0x69, 0xfd, 0x0c, 0x5a, 0xb3,
+	0x23, 0x22, 0xb7, 0x9b, 0xe2, 0xea, 0xe4, 0x0d, 0x82, 0x68, 0x3c, 0xbb, 0xec, 0x68, 0x32, 0xd5,
+	0xe7, 0x6a, 0xba, 0xcf, 0x16, 0x07, 0xb2, 0x78, 0x69, 0xcf, 0xb5, 0x3d, 0x0b, 0xb6, 0x72, 0x46,
+	0xb0, 0xd5, 0x4c, 0xb0, 0x02, 0x0a, 0x42, 0xd4, 0x3e, 0x55, 0x7e, 0x33, 0x7a, 0xe7, 0xaf, 0x9b,
+	0x1a, 0x0a, 0x30, 0x3c, 0xf5, 0x5c, 0x24, 0x13, 0x58, 0x4d, 0x4f, 0xf5, 0xc8, 0xce, 0x92, 0x13,
+	0xa8, 0xd4, 0x3c, 0xd1, 0xbc, 0x53, 0x4a, 0x47, 0x1f, 0x9c, 0xbf, 0x37, 0x60, 0x63, 0x6e, 0x5a,
+	0x47, 0xee, 0x2e, 0x6d, 0x28, 0x3d, 0x0d, 0x34, 0x3f, 0x2d, 0xab, 0xa6, 0x43, 0xf8, 0x9b, 0x91,
+	0x0c, 0x18, 0xb3, 0x13, 0x35, 0xf2, 0xd9, 0x39, 0x07, 0x71, 0x2a, 0xa0, 0xef, 0xbf, 0xd5, 0x18,
+	0x8f, 0x7c, 0x6d, 0xc0, 0xd6, 0xc2, 0x3c, 0x8b, 0xdc, 0x2b, 0x30, 0x7a, 0xd6, 0x04, 0xce, 0xbc,
+	0x5f, 0x5e, 0x31, 0x15, 0xc8, 0xc2, 0xcc, 0xa9, 0x30, 0x90, 0xb3, 0x46, 0x61, 0xe6, 0xfd, 0xf2,
+	0x8a, 0x3a, 0x90, 0x3f, 0x18, 0xb0, 0x39, 0x3f, 0x30, 0x22, 0x9f, 0x2e, 0x91, 0x57, 0xce, 0x38,
+	0xcb, 0xbc, 0x57, 0x5a, 0x4f, 0x47, 0xf1, 0x67, 0x7d, 0x9d, 0xcf, 0xe2, 0x24, 0x59, 0xa6, 0xbe,
+	0xb9, 0xc3, 0x28, 0xf3, 0xc1, 0x39, 0x34, 0x75, 0x2c, 0x5f, 0xc1, 0x5a, 0x66, 0x6e, 0x42, 0xee,
+	0x2c, 0x61, 0x6b, 0x7e, 0x16, 0x64, 0x7e, 0xaf, 0x9c, 0x92, 0xf6, 0xfd, 0x17, 0x43, 0x3d, 0x26,
+	0xe6, 0xe0, 0x8b, 0x2c, 0x93, 0x4e, 0xfe, 0xb4, 0xc5, 0x7c, 0x78, 0x1e, 0x55, 0x1d, 0xce, 0x4b,
+	0x80, 0xe4, 0x21, 0x4d, 0x3e, 0x29, 0xb0, 0xb4, 0x30, 0x0b, 0x31, 0x6f, 0x97, 0xd0, 0x48, 0x5c,
+	0x26, 0xcf, 0xe7, 0x42, 0x97, 0x0b, 0xef, 0x72, 0xf3, 0x76, 0x09, 0x0d, 0xed, 0xf2, 0x77, 0xb0,
+	0x9e, 0x7d, 0xf8, 0x92, 0xa2, 0xe6, 0xe5, 0xbe, 0xd7, 0xcd, 0xbb, 0x25, 0xb5, 0xb4, 0x7b, 0x0e,
+	0xed, 0xd4, 0x0b, 0x97, 0x14, 0xd6, 0x6c, 0xe1, 0x19, 0x6d, 0xee, 0x94, 0x51, 0x49, 0xbc, 0xa6,
+	0x5e, 0xad, 0x85, 0x5e, 0x17, 0xdf, 0xda, 0xe6, 0x4e, 0x19, 0x95, 0xa4, 0xd4, 0xd9, 0x47, 0x25,
+	0x59, 0x66, 0x9f, 0x2c, 0xbc, 0x6e, 0xcd, 0xbb, 0x25, 0xb5, 0xb4, 0xfb, 0x00, 0x5a, 0xb3, 0x77,
+	0x26, 0xb9, 0x55, 0xd8, 0xae, 0xec, 0x2b, 0xd5, 0xfc, 0x64, 0x79, 0x05, 0xe5, 0x6f, 0x0f, 0x5e,
+	0x34, 0xe3, 0x7f, 0x3e, 0x1e, 0xad, 0xc8, 0x6b, 0xd3, 0x9d, 0xff, 0x0f, 0x00, 0x86, 0x34, 0x15,
+	0xf9, 0x8f, 0x1c, 0x00, 0x00,
 }